@@ -52,6 +52,10 @@ The `group/nist` package provides NIST-standardized elliptic curves built on
 the Go crypto library.
 The 'group/edwards25519' sub-package provides the kyber.Group interface
 using the popular Ed25519 curve.
+The 'group/ed448' sub-package provides the Decaf448 prime-order group
+built on the Ed448-Goldilocks curve, for a 224-bit security level.
+The 'group/secp256k1' sub-package provides the secp256k1 curve
+used by Bitcoin and Ethereum.
 
 Other sub-packages build more interesting high-level cryptographic tools
 atop these primitive interfaces, including:
@@ -2,6 +2,7 @@ package kyber
 
 import (
 	"crypto/cipher"
+	"math/big"
 )
 
 // A Scalar kyber.y represents a scalar value by which
@@ -127,6 +128,111 @@ type AllowsVarTime interface {
 	AllowVarTime(bool)
 }
 
+// HashablePoint is an optional interface implemented by points that can
+// hash arbitrary messages to group elements in a way that reveals nothing
+// about their discrete logarithm, as required for instance by BLS signatures.
+// Hash sets the receiver to the hash of msg and returns it. The domain
+// separation tag dst must be unique to the protocol and to each of its
+// usages of the hash function, so that hashes computed in different
+// contexts are independent.
+type HashablePoint interface {
+	Hash(msg, dst []byte) Point
+}
+
+// HashSuitePoint is an optional interface implemented by hashable points
+// whose Hash follows a suite of RFC 9380. HashSuite returns the identifier of
+// this suite, such as "BLS12381G1_XMD:SHA-256_SSWU_RO_", from which protocols
+// derive their standard domain separation tags.
+type HashSuitePoint interface {
+	HashablePoint
+	HashSuite() string
+}
+
+// MultiScalarMul is an optional interface implemented by points that can
+// compute a sum of scalar multiples faster than one Mul at a time.
+// MultiMul sets the receiver to scalars[0]*points[0] + ... +
+// scalars[n-1]*points[n-1] and returns it, where a nil point stands for the
+// standard base point. The two slices must have the same length.
+// Implementations may run in variable time, so MultiMul must only be used
+// on public values, as for instance when verifying signatures or proofs.
+// Use util/msm.MultiMul to fall back to plain Mul and Add on groups that do
+// not implement this interface.
+type MultiScalarMul interface {
+	MultiMul(scalars []Scalar, points []Point) Point
+}
+
+// Precomputable is an optional interface implemented by points that can
+// speed up their repeated use as the point argument of Mul, as is the case
+// for public keys or for the second generator of Pedersen commitments.
+// Precompute returns a copy of the point carrying a table of its multiples,
+// which Mul uses for as long as the copy is not modified. Building the table
+// costs a few multiplications and some memory, so it only pays off for
+// points that are multiplied many times.
+// Use util/msm.Precompute to leave points of other groups unchanged.
+type Precomputable interface {
+	Precompute() Point
+}
+
+// ExtendedScalar is an optional interface implemented by scalars that offer
+// the field operations of prime-order groups beyond the ones of Scalar.
+// Exp sets the receiver to a^e for any integer exponent e and returns it.
+// Sqrt sets the receiver to a square root of a and returns true if a is a
+// square, or returns false otherwise.
+// Legendre returns the Legendre symbol of the scalar: 0 for zero, 1 for
+// the non-zero squares and -1 for the non-squares.
+// SetBytesWide sets the receiver to b, interpreted with the byte order of
+// SetBytes, reduced modulo the order of the group, and returns it. When b is
+// uniformly random and at least 16 bytes longer than the Scalars, the result
+// is indistinguishable from a uniform Scalar, which makes SetBytesWide the
+// way to hash to Scalars. Use util/scalar to fall back to SetBytes on
+// groups that do not implement this interface.
+// Sqrt and Legendre are only meaningful when the order of the group is
+// prime, and may run in variable time.
+type ExtendedScalar interface {
+	Exp(a Scalar, e *big.Int) Scalar
+	Sqrt(a Scalar) bool
+	Legendre() int
+	SetBytesWide(b []byte) Scalar
+}
+
+// Validator is an optional interface implemented by points that can check
+// their membership in the subgroup generated by the standard base point,
+// whose order is the modulus of the Scalars. Protocols must reject the
+// points they receive from other parties that are not in this subgroup, or
+// whose order is small, as these can leak information about secret scalars
+// or bias the outcome of a protocol. Use util/strict to decode and check
+// points in a generic way.
+// Valid reports whether the point is a multiple of the base point, the
+// identity element included.
+// IsSmallOrder reports whether the order of the point divides the cofactor
+// of the group, which is the case of the identity element and, in groups
+// with a cofactor, of the elements of small order.
+// ClearCofactor sets the receiver to a multiple of p that is in the subgroup
+// of the base point, by multiplying p by the cofactor of the group or by an
+// equivalent multiplier, and returns it.
+type Validator interface {
+	Valid() bool
+	IsSmallOrder() bool
+	ClearCofactor(p Point) Point
+}
+
+// GroupInfo is an optional interface implemented by groups that describe
+// their structure, so that generic code can validate its inputs and pick
+// safe parameters.
+// Order returns the order of the standard base point, which is also the
+// modulus of the Scalars of the group.
+// Cofactor returns the number of elements of the group underlying the
+// implementation, such as the points of an elliptic curve, divided by Order.
+// IsPrimeOrder reports whether Order is prime and the group is the subgroup
+// generated by the base point. Unless Cofactor is 1, UnmarshalBinary may
+// still accept elements outside of this subgroup in some groups, so that
+// Points received from other parties should be checked with Validator.
+type GroupInfo interface {
+	Order() *big.Int
+	Cofactor() *big.Int
+	IsPrimeOrder() bool
+}
+
 // Group interface represents a mathematical group
 // usable for Diffie-Hellman key exchange, ElGamal encryption,
 // and the related body of public-key cryptographic algorithms
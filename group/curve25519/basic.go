@@ -198,6 +198,22 @@ func (P *basicPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// Valid reports whether P is on the curve and in the subgroup
+// generated by the base point.
+func (P *basicPoint) Valid() bool {
+	return P.c.validPoint(P)
+}
+
+// IsSmallOrder reports whether the order of P divides the cofactor R.
+func (P *basicPoint) IsSmallOrder() bool {
+	return P.c.smallOrder(P)
+}
+
+// ClearCofactor sets P to R times A, which is in the prime-order subgroup.
+func (P *basicPoint) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Mul(&P.c.cofact, A)
+}
+
 // Basic unoptimized reference implementation of Twisted Edwards curves.
 // This reference implementation is mainly intended for testing, debugging,
 // and instructional uses, and not for production use.
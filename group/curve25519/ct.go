@@ -0,0 +1,479 @@
+package curve25519
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/mod"
+)
+
+// ConstantTimeCurve implements the prime-order subgroup of any Twisted
+// Edwards curve defined by a ConstantTimeParam, in constant time, so that it
+// can be used with secret scalars without the vartime build tag.
+// Coordinates and Scalars are mod.MontInts, and points use the extended
+// coordinates of ExtendedCurve, whose unified addition formulas have no
+// exceptional cases on the curves of this package, since their parameter a
+// is a square and d is not. Scalar multiplication uses a fixed window and
+// table lookups that do not depend on the scalar.
+//
+// Points and Scalars are encoded as the ones of ExtendedCurve, except that
+// decoding rejects non-canonical encodings.
+type ConstantTimeCurve struct {
+	ConstantTimeParam
+	fp, fq     *mod.Field  // Fields of the coordinates and of the Scalars
+	zero, one  mod.MontInt // Constant coordinates
+	a, d       mod.MontInt // Curve equation parameters
+	order      []byte      // Q in big-endian form, for validation
+	cofactor   []byte      // R in big-endian form
+	null, base ctPoint
+}
+
+// Init initializes the curve with the given parameters, using the standard
+// base point of the prime-order subgroup, or the first one found from y = 2
+// upwards as ExtendedCurve does if the parameters do not define one.
+func (c *ConstantTimeCurve) Init(p *ConstantTimeParam) *ConstantTimeCurve {
+	c.ConstantTimeParam = *p
+	c.fp = mod.NewField(&c.P)
+	c.fq = mod.NewField(&c.Q)
+	c.zero.Init(zero, c.fp)
+	c.one.Init(one, c.fp)
+	c.a.Init(&c.A, c.fp)
+	c.d.Init(&c.D, c.fp)
+	c.order = c.Q.Bytes()
+	c.cofactor = big.NewInt(int64(c.R)).Bytes()
+
+	c.null.setXY(&c.zero, &c.one, c)
+	if c.PBY.Sign() != 0 {
+		c.base.setXY(mod.NewMontInt(&c.PBX, c.fp), mod.NewMontInt(&c.PBY, c.fp), c)
+	} else {
+		var x mod.MontInt
+		y := mod.NewMontInt64(2, c.fp)
+		for ; ; y.Add(y, &c.one) {
+			if !c.solveForX(&x, y) {
+				continue
+			}
+			condNeg(&x, x.IsOdd())
+			if c.base.setXY(&x, y, c); c.base.Valid() {
+				break
+			}
+			if c.base.setXY(x.Neg(&x).(*mod.MontInt), y, c); c.base.Valid() {
+				break
+			}
+		}
+	}
+	if !c.base.Valid() {
+		panic("invalid base point " + c.base.String())
+	}
+	return c
+}
+
+func (c *ConstantTimeCurve) String() string {
+	return c.ConstantTimeParam.String()
+}
+
+// ScalarLen returns the size in bytes of an encoded Scalar.
+func (c *ConstantTimeCurve) ScalarLen() int {
+	return (c.Q.BitLen() + 7) / 8
+}
+
+// Scalar creates a new Scalar modulo the order of the base point.
+func (c *ConstantTimeCurve) Scalar() kyber.Scalar {
+	return mod.NewMontInt64(0, c.fq)
+}
+
+// PointLen returns the size in bytes of an encoded Point, which consists
+// of the y-coordinate and the sign bit of the x-coordinate.
+func (c *ConstantTimeCurve) PointLen() int {
+	return (c.P.BitLen() + 7 + 1) / 8
+}
+
+// Point creates a new Point set to the identity element.
+func (c *ConstantTimeCurve) Point() kyber.Point {
+	P := new(ctPoint)
+	P.Set(&c.null)
+	return P
+}
+
+// Order returns the prime order Q of the base point.
+func (c *ConstantTimeCurve) Order() *big.Int {
+	return new(big.Int).Set(&c.Q)
+}
+
+// Cofactor returns the cofactor R of the curve.
+func (c *ConstantTimeCurve) Cofactor() *big.Int {
+	return big.NewInt(int64(c.R))
+}
+
+// IsPrimeOrder returns true, as ExtendedCurve does for the prime-order
+// subgroup.
+func (c *ConstantTimeCurve) IsPrimeOrder() bool {
+	return true
+}
+
+// solveForX sets x to a square root of (1 - y^2)/(a - d*y^2), and returns
+// false if there is none, in which case y is not the coordinate of a point.
+func (c *ConstantTimeCurve) solveForX(x, y *mod.MontInt) bool {
+	var yy, t1, t2 mod.MontInt
+	yy.Mul(y, y)
+	t1.Sub(&c.one, &yy)
+	t2.Mul(&c.d, &yy).Sub(&c.a, &t2)
+	t2.Div(&t1, &t2)
+	return x.Sqrt(&t2)
+}
+
+// embedLen returns the number of bytes that can be embedded into points.
+func (c *ConstantTimeCurve) embedLen() int {
+	// Reserve at least 8 most-significant bits for randomness,
+	// and the least-significant 8 bits for embedded data length.
+	return (c.P.BitLen() - 8 - 8) / 8
+}
+
+type ctPoint struct {
+	X, Y, Z, T mod.MontInt
+	c          *ConstantTimeCurve
+}
+
+// setXY sets P to the affine point (x,y).
+func (P *ctPoint) setXY(x, y *mod.MontInt, c *ConstantTimeCurve) {
+	P.c = c
+	P.X.Set(x)
+	P.Y.Set(y)
+	P.Z.Set(&c.one)
+	P.T.Mul(x, y)
+}
+
+// normalize sets the Z coordinate of P to 1.
+func (P *ctPoint) normalize() {
+	P.Z.Inv(&P.Z)
+	P.X.Mul(&P.X, &P.Z)
+	P.Y.Mul(&P.Y, &P.Z)
+	P.Z.One()
+	P.T.Mul(&P.X, &P.Y)
+}
+
+func (P *ctPoint) String() string {
+	buf, _ := P.MarshalBinary()
+	return hex.EncodeToString(buf)
+}
+
+func (P *ctPoint) MarshalSize() int {
+	return P.c.PointLen()
+}
+
+// MarshalBinary encodes the y-coordinate in little-endian form, with the
+// least-significant bit of the x-coordinate in its most-significant bit.
+func (P *ctPoint) MarshalBinary() ([]byte, error) {
+	P.normalize()
+	b, _ := P.Y.MarshalBinary()
+	if P.c.P.BitLen()&7 == 0 {
+		b = append(make([]byte, 1), b...)
+	}
+	b[0] |= byte(P.X.IsOdd()) << 7
+	return reverse(b, b), nil
+}
+
+func (P *ctPoint) UnmarshalBinary(buf []byte) error {
+	if len(buf) != P.c.PointLen() {
+		return errors.New("invalid elliptic curve point length")
+	}
+	b := reverse(make([]byte, len(buf)), buf)
+	xsign := int(b[0] >> 7)
+	b[0] &^= 0x80
+	if P.c.P.BitLen()&7 == 0 {
+		if b[0] != 0 {
+			return errors.New("invalid elliptic curve point")
+		}
+		b = b[1:]
+	}
+
+	y := mod.NewMontInt64(0, P.c.fp)
+	if err := y.UnmarshalBinary(b); err != nil {
+		return errors.New("invalid elliptic curve point")
+	}
+	var x mod.MontInt
+	if !P.c.solveForX(&x, y) {
+		return errors.New("invalid elliptic curve point")
+	}
+	if x.Equal(&P.c.zero) && xsign == 1 {
+		return errors.New("invalid elliptic curve point")
+	}
+	condNeg(&x, x.IsOdd()^xsign)
+	P.setXY(&x, y, P.c)
+	return nil
+}
+
+func (P *ctPoint) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+func (P *ctPoint) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
+
+// Equality test for two Points on the same curve, without inversions:
+// (X1/Z1,Y1/Z1) == (X2/Z2,Y2/Z2) iff (X1*Z2,Y1*Z2) == (X2*Z1,Y2*Z1).
+func (P *ctPoint) Equal(CP2 kyber.Point) bool {
+	P2 := CP2.(*ctPoint)
+	var t1, t2 mod.MontInt
+	xeq := t1.Mul(&P.X, &P2.Z).Equal(t2.Mul(&P2.X, &P.Z))
+	yeq := t1.Mul(&P.Y, &P2.Z).Equal(t2.Mul(&P2.Y, &P.Z))
+	return xeq && yeq
+}
+
+func (P *ctPoint) Set(CP2 kyber.Point) kyber.Point {
+	P2 := CP2.(*ctPoint)
+	P.c = P2.c
+	P.X.Set(&P2.X)
+	P.Y.Set(&P2.Y)
+	P.Z.Set(&P2.Z)
+	P.T.Set(&P2.T)
+	return P
+}
+
+func (P *ctPoint) Clone() kyber.Point {
+	return new(ctPoint).Set(P)
+}
+
+func (P *ctPoint) Null() kyber.Point {
+	return P.Set(&P.c.null)
+}
+
+func (P *ctPoint) Base() kyber.Point {
+	return P.Set(&P.c.base)
+}
+
+func (P *ctPoint) EmbedLen() int {
+	return P.c.embedLen()
+}
+
+// Embed picks a random point of the prime-order subgroup, whose encoding
+// holds the length of data in its first byte followed by up to EmbedLen
+// bytes of data, as ExtendedCurve does.
+func (P *ctPoint) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	c := P.c
+	dl := c.embedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	var x mod.MontInt
+	y := mod.NewMontInt64(0, c.fp)
+	Q := new(ctPoint)
+	for {
+		// Get random bits the size of a compressed Point encoding,
+		// in which the topmost bit is reserved for the x-coord sign.
+		b := make([]byte, c.PointLen())
+		rand.XORKeyStream(b, b) // Interpret as little-endian
+		if data != nil {
+			b[0] = byte(dl)       // Encode length in low 8 bits
+			copy(b[1:1+dl], data) // Copy in data to embed
+		}
+		reverse(b, b) // Convert to big-endian form
+
+		xsign := int(b[0] >> 7)
+		b[0] &^= 0xff << uint(c.P.BitLen()&7)
+		y.SetBytes(b)
+		if !c.solveForX(&x, y) {
+			continue
+		}
+		condNeg(&x, x.IsOdd()^xsign)
+		P.setXY(&x, y, c)
+
+		// Without data to embed, multiplying by the cofactor gives a point
+		// of the subgroup; otherwise, retry until the point is in it.
+		if data == nil {
+			P.mul(c.cofactor, P)
+			if P.Equal(&c.null) {
+				continue
+			}
+			return P
+		}
+		if Q.mul(c.order, P).Equal(&c.null) {
+			return P
+		}
+	}
+}
+
+func (P *ctPoint) Pick(rand cipher.Stream) kyber.Point {
+	return P.Embed(nil, rand)
+}
+
+// Data extracts the data embedded in a point by Embed.
+func (P *ctPoint) Data() ([]byte, error) {
+	b, _ := P.MarshalBinary()
+	dl := int(b[0])
+	if dl > P.c.embedLen() {
+		return nil, errors.New("invalid embedded data length")
+	}
+	return b[1 : 1+dl], nil
+}
+
+// Add two points using the unified extended coordinate addition formulas.
+func (P *ctPoint) Add(CP1, CP2 kyber.Point) kyber.Point {
+	P1 := CP1.(*ctPoint)
+	P2 := CP2.(*ctPoint)
+	var A, B, C, D, E, F, G, H mod.MontInt
+
+	A.Mul(&P1.X, &P2.X)
+	B.Mul(&P1.Y, &P2.Y)
+	C.Mul(&P1.T, &P2.T).Mul(&C, &P1.c.d)
+	D.Mul(&P1.Z, &P2.Z)
+	E.Add(&P1.X, &P1.Y).Mul(&E, F.Add(&P2.X, &P2.Y)).Sub(&E, &A).Sub(&E, &B)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	H.Mul(&P1.c.a, &A).Sub(&B, &H)
+	P.c = P1.c
+	P.X.Mul(&E, &F)
+	P.Y.Mul(&G, &H)
+	P.T.Mul(&E, &H)
+	P.Z.Mul(&F, &G)
+	return P
+}
+
+func (P *ctPoint) Sub(CP1, CP2 kyber.Point) kyber.Point {
+	var N ctPoint
+	N.Neg(CP2)
+	return P.Add(CP1, &N)
+}
+
+// Neg sets P to -A. The negative of (x,y) is (-x,y).
+func (P *ctPoint) Neg(CA kyber.Point) kyber.Point {
+	A := CA.(*ctPoint)
+	P.c = A.c
+	P.X.Neg(&A.X)
+	P.Y.Set(&A.Y)
+	P.Z.Set(&A.Z)
+	P.T.Neg(&A.T)
+	return P
+}
+
+// double sets P to 2*P, with the formulas of ExtendedCurve, which have no
+// exceptional cases either.
+func (P *ctPoint) double() {
+	var A, B, C, D, E, F, G, H mod.MontInt
+
+	A.Mul(&P.X, &P.X)
+	B.Mul(&P.Y, &P.Y)
+	C.Mul(&P.Z, &P.Z).Add(&C, &C)
+	D.Mul(&P.c.a, &A)
+	E.Add(&P.X, &P.Y).Mul(&E, &E).Sub(&E, &A).Sub(&E, &B)
+	G.Add(&D, &B)
+	F.Sub(&G, &C)
+	H.Sub(&D, &B)
+	P.X.Mul(&E, &F)
+	P.Y.Mul(&G, &H)
+	P.T.Mul(&E, &H)
+	P.Z.Mul(&F, &G)
+}
+
+// selectPoint sets P to A if cond is 1, or leaves it unchanged if cond is 0,
+// in constant time.
+func (P *ctPoint) selectPoint(A *ctPoint, cond int) {
+	P.X.Select(&A.X, &P.X, cond)
+	P.Y.Select(&A.Y, &P.Y, cond)
+	P.Z.Select(&A.Z, &P.Z, cond)
+	P.T.Select(&A.T, &P.T, cond)
+}
+
+// mul sets P to k*G, where k is a big-endian integer, with a fixed window of
+// 4 bits. Its running time depends only on the length of k.
+func (P *ctPoint) mul(k []byte, G *ctPoint) *ctPoint {
+	var table [16]ctPoint
+	table[0].Set(&G.c.null)
+	table[1].Set(G)
+	for i := 2; i < 16; i++ {
+		table[i].Add(&table[i-1], G)
+	}
+
+	var acc, t ctPoint
+	acc.Set(&G.c.null)
+	t.Set(&G.c.null)
+	for _, b := range k {
+		for _, w := range [2]int{int(b >> 4), int(b & 0xf)} {
+			for j := 0; j < 4; j++ {
+				acc.double()
+			}
+			for i := range table {
+				t.selectPoint(&table[i], subtleEq(i, w))
+			}
+			acc.Add(&acc, &t)
+		}
+	}
+	P.Set(&acc)
+	return P
+}
+
+// condNeg negates x if cond is 1, and leaves it unchanged if cond is 0, in
+// constant time.
+func condNeg(x *mod.MontInt, cond int) {
+	var n mod.MontInt
+	n.Neg(x)
+	x.Select(&n, x, cond)
+}
+
+// subtleEq returns 1 if x == y and 0 otherwise, in constant time, for
+// values between 0 and 255.
+func subtleEq(x, y int) int {
+	return int((uint32(x^y) - 1) >> 31)
+}
+
+// Mul multiplies point G by the scalar s in constant time, or the base
+// point if G is nil. The scalar must be one created by this curve.
+func (P *ctPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
+	if G == nil {
+		G = &P.c.base
+	}
+	k := *s.(*mod.MontInt)
+	k.BO = mod.BigEndian
+	b, _ := k.MarshalBinary()
+	return P.mul(b, G.(*ctPoint))
+}
+
+// onCurve checks the characteristic equation a*x^2 + y^2 = 1 + d*x^2*y^2
+// of P, multiplied by Z^4.
+func (P *ctPoint) onCurve() bool {
+	var xx, yy, zz, l, r mod.MontInt
+	xx.Mul(&P.X, &P.X)
+	yy.Mul(&P.Y, &P.Y)
+	zz.Mul(&P.Z, &P.Z)
+	l.Mul(&P.c.a, &xx).Add(&l, &yy).Mul(&l, &zz)
+	r.Mul(&P.c.d, &xx).Mul(&r, &yy).Add(&r, zz.Mul(&zz, &zz))
+	return !P.Z.Equal(&P.c.zero) && l.Equal(&r)
+}
+
+// Valid reports whether P is on the curve and in the subgroup generated by
+// the base point.
+func (P *ctPoint) Valid() bool {
+	if !P.onCurve() {
+		return false
+	}
+	return new(ctPoint).mul(P.c.order, P).Equal(&P.c.null)
+}
+
+// IsSmallOrder reports whether the order of P divides the cofactor R.
+func (P *ctPoint) IsSmallOrder() bool {
+	return new(ctPoint).mul(P.c.cofactor, P).Equal(&P.c.null)
+}
+
+// ClearCofactor sets P to R times A, which is in the prime-order subgroup.
+func (P *ctPoint) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.mul(P.c.cofactor, A.(*ctPoint))
+}
+
+// reverse copies src into dst in byte-reversed order and returns dst,
+// such that src[0] goes into dst[len-1] and vice versa.
+// dst and src may be the same slice but otherwise must not overlap.
+func reverse(dst, src []byte) []byte {
+	l := len(dst)
+	for i, j := 0, l-1; i < (l+1)/2; {
+		dst[i], dst[j] = src[j], src[i]
+		i++
+		j--
+	}
+	return dst
+}
@@ -0,0 +1,53 @@
+package curve25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstantTime1174(t *testing.T) {
+	test.GroupTest(new(ConstantTimeCurve).Init(ConstantTimeParam1174()))
+}
+
+func TestConstantTime25519(t *testing.T) {
+	test.GroupTest(new(ConstantTimeCurve).Init(ConstantTimeParam25519()))
+}
+
+func TestConstantTimeE382(t *testing.T) {
+	test.GroupTest(new(ConstantTimeCurve).Init(ConstantTimeParamE382()))
+}
+
+func TestConstantTime41417(t *testing.T) {
+	test.GroupTest(new(ConstantTimeCurve).Init(ConstantTimeParam41417()))
+}
+
+func TestConstantTimeE521(t *testing.T) {
+	test.SuiteTest(NewBlakeSHA256ConstantTime(ConstantTimeParamE521()))
+}
+
+func TestConstantTimeWithRand(t *testing.T) {
+	r := random.New()
+	suite := NewBlakeSHA256ConstantTimeWithRand(ConstantTimeParam25519(), r)
+	require.Equal(t, r, suite.RandomStream())
+	require.NotNil(t, NewBlakeSHA256ConstantTime(ConstantTimeParam25519()).RandomStream())
+}
+
+func TestConstantTimeDecoding(t *testing.T) {
+	c := new(ConstantTimeCurve).Init(ConstantTimeParamE521())
+	buf, err := c.Point().Base().MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, c.PointLen(), len(buf))
+
+	P := c.Point()
+	require.NoError(t, P.UnmarshalBinary(buf))
+	require.True(t, P.Equal(c.Point().Base()))
+
+	// E-521 encodings have a whole byte for the sign of x, whose other
+	// bits must be zero.
+	buf[len(buf)-1] |= 1
+	require.Error(t, P.UnmarshalBinary(buf))
+	require.Error(t, P.UnmarshalBinary(buf[1:]))
+}
@@ -0,0 +1,73 @@
+package curve25519
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake2xb"
+)
+
+// SuiteConstantTime is a cipher suite based on a ConstantTimeCurve.
+type SuiteConstantTime struct {
+	ConstantTimeCurve
+	r cipher.Stream
+}
+
+// SHA256 hash function
+func (s *SuiteConstantTime) Hash() hash.Hash {
+	return sha256.New()
+}
+
+func (s *SuiteConstantTime) XOF(seed []byte) kyber.XOF {
+	return blake2xb.New(seed)
+}
+
+func (s *SuiteConstantTime) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteConstantTime) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs...)
+}
+
+func (s *SuiteConstantTime) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns the stream given to
+// NewBlakeSHA256ConstantTimeWithRand, or a key stream from crypto/rand.
+func (s *SuiteConstantTime) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+// NewBlakeSHA256ConstantTime returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the prime-order subgroup
+// of the curve with parameters p, implemented in constant time, such as
+// ConstantTimeParamE521().
+//
+// As with NewBlakeSHA256Curve25519, the scalars interpret the bytes of
+// SetBytes as a big-endian integer.
+func NewBlakeSHA256ConstantTime(p *ConstantTimeParam) *SuiteConstantTime {
+	suite := new(SuiteConstantTime)
+	suite.Init(p)
+	return suite
+}
+
+// NewBlakeSHA256ConstantTimeWithRand returns the cipher suite of
+// NewBlakeSHA256ConstantTime, which produces cryptographically random
+// numbers via the provided stream r.
+func NewBlakeSHA256ConstantTimeWithRand(p *ConstantTimeParam, r cipher.Stream) *SuiteConstantTime {
+	suite := NewBlakeSHA256ConstantTime(p)
+	suite.r = r
+	return suite
+}
@@ -14,9 +14,6 @@ import (
 	"github.com/dedis/kyber/util/random"
 )
 
-var zero = big.NewInt(0)
-var one = big.NewInt(1)
-
 // Extension of Point interface for elliptic curve X,Y coordinate access
 type point interface {
 	kyber.Point
@@ -58,6 +55,23 @@ func (c *curve) String() string {
 	return c.Param.String()
 }
 
+// Returns the order of the base point, which is the modulus of the Scalars:
+// the prime Q, or Q*R if we're using the full group.
+func (c *curve) Order() *big.Int {
+	return new(big.Int).Set(&c.order.V)
+}
+
+// Returns the cofactor R of the prime-order subgroup,
+// or 1 if we're using the full group.
+func (c *curve) Cofactor() *big.Int {
+	if c.full {
+		return big.NewInt(1)
+	}
+	return big.NewInt(int64(c.R))
+}
+
+// Returns true if we're using the prime-order subgroup,
+// or false if we're using the full group.
 func (c *curve) IsPrimeOrder() bool {
 	return !c.full
 }
@@ -310,6 +324,13 @@ func (c *curve) validPoint(P point) bool {
 	return true
 }
 
+// Check whether the order of a point divides the cofactor R of the curve.
+func (c *curve) smallOrder(P point) bool {
+	Q := c.self.Point()
+	Q.Mul(&c.cofact, P)
+	return Q.Equal(c.null)
+}
+
 // Return number of bytes that can be embedded into points on this curve.
 func (c *curve) embedLen() int {
 	// Reserve at least 8 most-significant bits for randomness,
@@ -405,15 +426,11 @@ func (c *curve) data(x, y *mod.Int) ([]byte, error) {
 	return b[1 : 1+dl], nil
 }
 
-// reverse copies src into dst in byte-reversed order and returns dst,
-// such that src[0] goes into dst[len-1] and vice versa.
-// dst and src may be the same slice but otherwise must not overlap.
-func reverse(dst, src []byte) []byte {
-	l := len(dst)
-	for i, j := 0, l-1; i < (l+1)/2; {
-		dst[i], dst[j] = src[j], src[i]
-		i++
-		j--
+// scalarInts returns the integer values of the given scalars.
+func scalarInts(scalars []kyber.Scalar) []*big.Int {
+	v := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		v[i] = &s.(*mod.Int).V
 	}
-	return dst
+	return v
 }
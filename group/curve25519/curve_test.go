@@ -3,6 +3,7 @@
 package curve25519
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dedis/kyber"
@@ -109,6 +110,54 @@ func TestCompareProjectiveExtendedE521(t *testing.T) {
 		new(ExtendedCurve).Init(ParamE521(), false))
 }
 
+// Test ExtendedCurve versus ConstantTimeCurve implementations
+
+func TestCompareExtendedConstantTime(t *testing.T) {
+	params := []struct {
+		p  *Param
+		ct *ConstantTimeParam
+	}{
+		{Param1174(), ConstantTimeParam1174()},
+		{Param25519(), ConstantTimeParam25519()},
+		{ParamE382(), ConstantTimeParamE382()},
+		{Param41417(), ConstantTimeParam41417()},
+		{ParamE521(), ConstantTimeParamE521()},
+	}
+	for _, p := range params {
+		test.CompareGroups(testSuite.XOF,
+			new(ExtendedCurve).Init(p.p, false),
+			new(ConstantTimeCurve).Init(p.ct))
+	}
+}
+
+// Each ConstantTimeParam is the one that its Param embeds.
+func TestConstantTimeParam(t *testing.T) {
+	params := []struct {
+		p  *Param
+		ct *ConstantTimeParam
+	}{
+		{Param1174(), ConstantTimeParam1174()},
+		{Param25519(), ConstantTimeParam25519()},
+		{ParamE382(), ConstantTimeParamE382()},
+		{Param41417(), ConstantTimeParam41417()},
+		{ParamE521(), ConstantTimeParamE521()},
+	}
+	for _, p := range params {
+		ct := &p.p.ConstantTimeParam
+		if ct.Name != p.ct.Name || ct.R != p.ct.R {
+			t.Fatalf("%s: name or cofactor differs", p.ct)
+		}
+		for i, v := range [][2]*big.Int{
+			{&ct.P, &p.ct.P}, {&ct.Q, &p.ct.Q}, {&ct.A, &p.ct.A},
+			{&ct.D, &p.ct.D}, {&ct.PBX, &p.ct.PBX}, {&ct.PBY, &p.ct.PBY},
+		} {
+			if v[0].Cmp(v[1]) != 0 {
+				t.Fatalf("%s: parameter %d differs", p.ct, i)
+			}
+		}
+	}
+}
+
 // Test Ed25519 versus ExtendedCurve implementations of Curve25519.
 func TestCompareEd25519(t *testing.T) {
 	test.CompareGroups(testSuite.XOF,
@@ -158,18 +207,22 @@ func TestElligator2(t *testing.T) {
 var projBench = test.NewGroupBench(new(ProjectiveCurve).Init(Param25519(), false))
 var extBench = test.NewGroupBench(new(ExtendedCurve).Init(Param25519(), false))
 var optBench = test.NewGroupBench(new(edwards25519.Curve))
-
-func BenchmarkPointAddProjective(b *testing.B) { projBench.PointAdd(b.N) }
-func BenchmarkPointAddExtended(b *testing.B)   { extBench.PointAdd(b.N) }
-func BenchmarkPointAddOptimized(b *testing.B)  { optBench.PointAdd(b.N) }
-
-func BenchmarkPointMulProjective(b *testing.B) { projBench.PointMul(b.N) }
-func BenchmarkPointMulExtended(b *testing.B)   { extBench.PointMul(b.N) }
-func BenchmarkPointMulOptimized(b *testing.B)  { optBench.PointMul(b.N) }
-
-func BenchmarkPointBaseMulProjective(b *testing.B) { projBench.PointBaseMul(b.N) }
-func BenchmarkPointBaseMulExtended(b *testing.B)   { extBench.PointBaseMul(b.N) }
-func BenchmarkPointBaseMulOptimized(b *testing.B)  { optBench.PointBaseMul(b.N) }
+var ctBench = test.NewGroupBench(new(ConstantTimeCurve).Init(ConstantTimeParam25519()))
+
+func BenchmarkPointAddProjective(b *testing.B)   { projBench.PointAdd(b.N) }
+func BenchmarkPointAddExtended(b *testing.B)     { extBench.PointAdd(b.N) }
+func BenchmarkPointAddOptimized(b *testing.B)    { optBench.PointAdd(b.N) }
+func BenchmarkPointAddConstantTime(b *testing.B) { ctBench.PointAdd(b.N) }
+
+func BenchmarkPointMulProjective(b *testing.B)   { projBench.PointMul(b.N) }
+func BenchmarkPointMulExtended(b *testing.B)     { extBench.PointMul(b.N) }
+func BenchmarkPointMulOptimized(b *testing.B)    { optBench.PointMul(b.N) }
+func BenchmarkPointMulConstantTime(b *testing.B) { ctBench.PointMul(b.N) }
+
+func BenchmarkPointBaseMulProjective(b *testing.B)   { projBench.PointBaseMul(b.N) }
+func BenchmarkPointBaseMulExtended(b *testing.B)     { extBench.PointBaseMul(b.N) }
+func BenchmarkPointBaseMulOptimized(b *testing.B)    { optBench.PointBaseMul(b.N) }
+func BenchmarkPointBaseMulConstantTime(b *testing.B) { ctBench.PointBaseMul(b.N) }
 
 func BenchmarkPointEncodeProjective(b *testing.B) { projBench.PointEncode(b.N) }
 func BenchmarkPointEncodeExtended(b *testing.B)   { extBench.PointEncode(b.N) }
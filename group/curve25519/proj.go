@@ -10,6 +10,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/msm"
 )
 
 type projPoint struct {
@@ -247,6 +248,28 @@ func (P *projPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// Valid reports whether P is on the curve and in the subgroup
+// generated by the base point.
+func (P *projPoint) Valid() bool {
+	return P.c.validPoint(P)
+}
+
+// IsSmallOrder reports whether the order of P divides the cofactor R.
+func (P *projPoint) IsSmallOrder() bool {
+	return P.c.smallOrder(P)
+}
+
+// ClearCofactor sets P to R times A, which is in the prime-order subgroup.
+func (P *projPoint) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Mul(&P.c.cofact, A)
+}
+
+// MultiMul sets P to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point.
+func (P *projPoint) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	return msm.MultiMulInt(P, scalarInts(scalars), points)
+}
+
 // ProjectiveCurve implements Twisted Edwards curves
 // using projective coordinate representation (X:Y:Z),
 // satisfying the identities x = X/Z, y = Y/Z.
@@ -0,0 +1,122 @@
+// Package ed448 provides the Decaf448 prime-order group of RFC 9496, built
+// on top of the Ed448-Goldilocks curve of RFC 7748 and RFC 8032.
+//
+// Ed448 is the untwisted Edwards curve x² + y² = 1 - 39081x²y² over GF(p),
+// with p = 2^448 - 2^224 - 1. It offers about 224 bits of security, but
+// its group of points has order 4l for a prime l of 446 bits. Decaf448
+// eliminates this cofactor: every element has a unique canonical 56-byte
+// encoding, and the group has prime order l, so protocols designed for
+// prime-order groups can be implemented on it without cofactor-related
+// pitfalls. Scalars are encoded as 56-byte little-endian integers.
+//
+// Internally, every Decaf448 element is represented by an Ed448 point, and
+// MarshalEd448 and UnmarshalEd448 convert elements from and to the points
+// of order l in the format of RFC 8032, which package
+// github.com/dedis/kyber/sign/eddsa uses to implement Ed448 signatures. The
+// generator of Decaf448 is twice the Ed448 base point. All operations on
+// points and scalars are constant time, except for decoding, which only
+// handles public values.
+package ed448
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber"
+)
+
+var (
+	// p is the order of the field of coordinates.
+	p, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+	// l is the order of the group,
+	// 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885.
+	l, _ = new(big.Int).SetString("3fffffffffffffffffffffffffffffffffffffffffffffffffffffff7cca23e9c44edb49aed63690216cc2728dc58f552378c292ab5844f3", 16)
+
+	baseField   = newField(p)
+	scalarField = newField(l)
+
+	// pMinus3Over4 is the exponent of the square roots modulo p = 3 mod 4.
+	pMinus3Over4 = new(big.Int).Rsh(new(big.Int).Sub(p, big.NewInt(3)), 2)
+
+	// curveD is the constant d = -39081 of the curve equation.
+	curveD        = baseField.elementOf(big.NewInt(-39081))
+	oneMinusD     = baseField.elementOf(big.NewInt(39082))
+	oneMinusTwoD  = baseField.elementOf(big.NewInt(78163))
+	sqrtMinusD    = sqrt(39081)
+	invSqrtMinusD = inverse(sqrtMinusD)
+
+	// ed448Base is the base point of Ed448, of order l, and basePoint is
+	// twice it, which represents the generator of Decaf448.
+	ed448Base = newAffinePoint(
+		fromDecimal("224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710"),
+		fromDecimal("298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660"),
+	)
+	basePoint = twice(ed448Base)
+)
+
+func fromDecimal(s string) *big.Int {
+	n, _ := new(big.Int).SetString(s, 10)
+	return n
+}
+
+func twice(A point) point {
+	var P point
+	P.double(&A)
+	return P
+}
+
+// Curve represents the Decaf448 group.
+// There are no parameters and no initialization is required
+// because it supports only this one specific group.
+type Curve struct {
+}
+
+// Return the name of the group, "Decaf448".
+func (c *Curve) String() string {
+	return "Decaf448"
+}
+
+// ScalarLen returns 56, the size in bytes of an encoded Scalar.
+func (c *Curve) ScalarLen() int {
+	return scalarLen
+}
+
+// Scalar creates a new Scalar modulo the order of the group.
+func (c *Curve) Scalar() kyber.Scalar {
+	return &scalar{}
+}
+
+// PointLen returns 56, the size in bytes of an encoded Point.
+func (c *Curve) PointLen() int {
+	return pointLen
+}
+
+// Point creates a new Point of the group, initialized to the identity
+// element.
+func (c *Curve) Point() kyber.Point {
+	P := &point{}
+	P.Null()
+	return P
+}
+
+// NewKey returns a uniformly random secret scalar. Since Decaf448 has prime
+// order, no clamping is needed.
+// NewKey implements the kyber/util/key.Generator interface.
+func (c *Curve) NewKey(stream cipher.Stream) kyber.Scalar {
+	return c.Scalar().Pick(stream)
+}
+
+// Order returns the prime order l of the group.
+func (c *Curve) Order() *big.Int {
+	return new(big.Int).Set(l)
+}
+
+// Cofactor returns 1, since Decaf448 has prime order.
+func (c *Curve) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+// IsPrimeOrder returns true.
+func (c *Curve) IsPrimeOrder() bool {
+	return true
+}
@@ -0,0 +1,110 @@
+package ed448
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+var tSuite = NewShakeSHA512Ed448()
+var groupBench = test.NewGroupBench(tSuite)
+
+func TestSuite(t *testing.T) { test.SuiteTest(tSuite) }
+
+// Encodings of the multiples 0, 1, 2 and 3 of the generator, taken from
+// the test vectors of RFC 9496.
+func TestVectors(t *testing.T) {
+	vectors := []string{
+		"0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		"6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333",
+		"c898eb4f87f97c564c6fd61fc7e49689314a1f818ec85eeb3bd5514ac816d38778f69ef347a89fca817e66defdedce178c7cc709b2116e75",
+		"a0c09bf2ba7208fda0f4bfe3d0f5b29a543012306d43831b5adc6fe7f8596fa308763db15468323b11cf6e4aeb8c18fe44678f44545a69bc",
+	}
+	P := tSuite.Point().Null()
+	for i, v := range vectors {
+		require.Equal(t, v, P.String(), "multiple %d", i)
+
+		Q := tSuite.Point()
+		b, _ := hex.DecodeString(v)
+		require.NoError(t, Q.UnmarshalBinary(b))
+		require.True(t, P.Equal(Q))
+
+		P.Add(P, tSuite.Point().Base())
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	P := tSuite.Point()
+	G, _ := P.Base().MarshalBinary()
+	require.Error(t, P.UnmarshalBinary(G[1:]))
+
+	// s = p is not reduced.
+	pb, _ := hex.DecodeString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	require.Error(t, P.UnmarshalBinary(pb))
+
+	// s = 1 is negative, and s = 4 does not encode a point.
+	b := make([]byte, 56)
+	b[0] = 1
+	require.Error(t, P.UnmarshalBinary(b))
+	b[0] = 4
+	require.Error(t, P.UnmarshalBinary(b))
+
+	// Scalars must be reduced modulo l.
+	lb, _ := hex.DecodeString("f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f")
+	require.Error(t, tSuite.Scalar().UnmarshalBinary(lb))
+}
+
+func TestEd448(t *testing.T) {
+	// The base point of Ed448, from RFC 8032 section 5.2.
+	B := "14fa30f25b790898adc8d74e2c13bdfdc4397ce61cffd33ad7c2a0051e9c78874098a36c7373ea4b62c7c9563720768824bcb66e71463f6900"
+	require.Equal(t, B, hex.EncodeToString(MarshalEd448(Ed448Base())))
+
+	G := tSuite.Point().Base()
+	require.True(t, G.Equal(tSuite.Point().Add(Ed448Base(), Ed448Base())))
+
+	// Elements decoded from their Decaf448 encoding have the same Ed448
+	// encoding as the points of order l they come from.
+	P := tSuite.Point().Pick(tSuite.RandomStream())
+	b := MarshalEd448(P)
+	Q, err := UnmarshalEd448(b)
+	require.NoError(t, err)
+	require.True(t, P.Equal(Q))
+	buf, _ := Q.MarshalBinary()
+	require.NoError(t, Q.UnmarshalBinary(buf))
+	require.Equal(t, b, MarshalEd448(Q))
+
+	// The point (0, -1) of order 2 encodes the identity element.
+	b = make([]byte, Ed448PointLen)
+	for i := range b[:56] {
+		b[i] = 0xff
+	}
+	b[0], b[28] = 0xfe, 0xfe
+	Q, err = UnmarshalEd448(b)
+	require.NoError(t, err)
+	require.True(t, Q.Equal(tSuite.Point().Null()))
+
+	b[Ed448PointLen-1] = 0x80
+	_, err = UnmarshalEd448(b)
+	require.Error(t, err)
+}
+
+func BenchmarkScalarAdd(b *testing.B)    { groupBench.ScalarAdd(b.N) }
+func BenchmarkScalarSub(b *testing.B)    { groupBench.ScalarSub(b.N) }
+func BenchmarkScalarNeg(b *testing.B)    { groupBench.ScalarNeg(b.N) }
+func BenchmarkScalarMul(b *testing.B)    { groupBench.ScalarMul(b.N) }
+func BenchmarkScalarDiv(b *testing.B)    { groupBench.ScalarDiv(b.N) }
+func BenchmarkScalarInv(b *testing.B)    { groupBench.ScalarInv(b.N) }
+func BenchmarkScalarPick(b *testing.B)   { groupBench.ScalarPick(b.N) }
+func BenchmarkScalarEncode(b *testing.B) { groupBench.ScalarEncode(b.N) }
+func BenchmarkScalarDecode(b *testing.B) { groupBench.ScalarDecode(b.N) }
+
+func BenchmarkPointAdd(b *testing.B)     { groupBench.PointAdd(b.N) }
+func BenchmarkPointSub(b *testing.B)     { groupBench.PointSub(b.N) }
+func BenchmarkPointNeg(b *testing.B)     { groupBench.PointNeg(b.N) }
+func BenchmarkPointMul(b *testing.B)     { groupBench.PointMul(b.N) }
+func BenchmarkPointBaseMul(b *testing.B) { groupBench.PointBaseMul(b.N) }
+func BenchmarkPointPick(b *testing.B)    { groupBench.PointPick(b.N) }
+func BenchmarkPointEncode(b *testing.B)  { groupBench.PointEncode(b.N) }
+func BenchmarkPointDecode(b *testing.B)  { groupBench.PointDecode(b.N) }
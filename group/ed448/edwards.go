@@ -0,0 +1,90 @@
+package ed448
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/montgomery"
+)
+
+// Ed448PointLen is the size in bytes of the encoding of Ed448 points of
+// RFC 8032, which is the format of Ed448 public keys.
+const Ed448PointLen = 57
+
+var (
+	// half and quarter are the inverses of 2 and 4 modulo l.
+	half    = scalarField.elementOf(new(big.Int).ModInverse(big.NewInt(2), l))
+	quarter = scalarField.elementOf(new(big.Int).ModInverse(big.NewInt(4), l))
+)
+
+// Ed448Base returns the element of the base point of Ed448, which is half
+// the generator of Decaf448 returned by Point.Base. Ed448 public keys and
+// signatures are computed from it.
+func Ed448Base() kyber.Point {
+	P := ed448Base
+	return &P
+}
+
+// MarshalEd448 returns the RFC 8032 encoding of the Ed448 point of order l
+// of the element P, or of the neutral point if P is the identity, which is
+// the Ed448 public key encoding of P.
+func MarshalEd448(P kyber.Point) []byte {
+	// P is represented by Q or Q + (0, -1), with Q of order l, and
+	// Q = (2P)/2 in both cases.
+	var Q point
+	Q.double(P.(*point))
+	Q.mul(&half, &Q)
+
+	var zInv, x, y element
+	baseField.Inv(&zInv, &Q.z)
+	baseField.Mul(&x, &Q.x, &zInv)
+	baseField.Mul(&y, &Q.y, &zInv)
+
+	b := make([]byte, Ed448PointLen)
+	copy(b, baseField.bytes(&y))
+	b[Ed448PointLen-1] = byte(baseField.isNegative(&x)) << 7
+	return b
+}
+
+// UnmarshalEd448 decodes an Ed448 point from its RFC 8032 encoding, and
+// returns the element of its component of order l. Since the component of
+// small order of the point, if any, is dropped, comparing elements returned
+// by UnmarshalEd448 is equivalent to the cofactored verification of Ed448
+// signatures.
+func UnmarshalEd448(b []byte) (kyber.Point, error) {
+	if len(b) != Ed448PointLen {
+		return nil, errors.New("ed448: wrong size buffer")
+	}
+	if b[Ed448PointLen-1]&0x7f != 0 {
+		return nil, errors.New("ed448: invalid Ed448 encoding")
+	}
+
+	f := baseField
+	var y, yy, u, v, x, negX element
+	if !f.setBytes(&y, b[:Ed448PointLen-1]) {
+		return nil, errors.New("ed448: y-coordinate is not reduced modulo p")
+	}
+	f.Square(&yy, &y)
+	f.Sub(&u, &yy, &f.one) // u = y^2 - 1
+	f.Mul(&v, &curveD, &yy)
+	f.Sub(&v, &v, &f.one) // v = d y^2 - 1
+	if sqrtRatio(&x, &u, &v) != 1 {
+		return nil, errors.New("ed448: point is not on the curve")
+	}
+
+	sign := uint64(b[Ed448PointLen-1] >> 7)
+	if sign == 1 && montgomery.Equal(&x, &element{}) == 1 {
+		return nil, errors.New("ed448: invalid Ed448 encoding")
+	}
+	f.Neg(&negX, &x)
+	montgomery.CMove(&x, &negX, sign)
+
+	// The component of order l of the point is (4P)/4.
+	P := &point{x: x, y: y, z: f.one}
+	f.Mul(&P.t, &x, &y)
+	P.double(P)
+	P.double(P)
+	P.mul(&quarter, P)
+	return P, nil
+}
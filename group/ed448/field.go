@@ -0,0 +1,75 @@
+package ed448
+
+import (
+	"math/big"
+
+	"github.com/dedis/kyber/group/internal/montgomery"
+)
+
+// element is an integer modulo p or l in Montgomery form. It is used both
+// for the coordinates of points, modulo p, and for scalars, modulo the group
+// order l.
+type element = montgomery.Element
+
+// field adds the little-endian encodings of RFC 8032 and RFC 9496 to the
+// constant-time arithmetic of package montgomery, whose Exp only runs in
+// variable time with respect to its public exponent.
+type field struct {
+	*montgomery.Field
+	one element // 1 in Montgomery form
+}
+
+func newField(m *big.Int) *field {
+	f := montgomery.NewField(m)
+	return &field{Field: f, one: f.One()}
+}
+
+// elementOf returns the Montgomery form of n mod m.
+func (f *field) elementOf(n *big.Int) element {
+	var z element
+	f.SetBig(&z, n)
+	return z
+}
+
+// setBytes sets z to the Montgomery form of the 56-byte little-endian
+// integer b, and returns false if it is not reduced modulo m, in which case
+// z is left unchanged.
+func (f *field) setBytes(z *element, b []byte) bool {
+	return f.SetBytes(z, reverse(b))
+}
+
+// setBytesWide sets z to the Montgomery form of the little-endian integer
+// b of any length, reduced modulo m.
+func (f *field) setBytesWide(z *element, b []byte) {
+	f.SetBytesWide(z, reverse(b))
+}
+
+// bytes returns the 56-byte little-endian encoding of the integer x
+// represents.
+func (f *field) bytes(x *element) []byte {
+	return reverse(f.Bytes(x))
+}
+
+// isNegative returns 1 if the integer x represents is odd, and 0 otherwise,
+// as odd integers are the negative ones in RFC 8032 and RFC 9496.
+func (f *field) isNegative(x *element) uint64 {
+	return f.IsOdd(x)
+}
+
+// abs sets z to the non-negative one of x and -x.
+func (f *field) abs(z, x *element) {
+	var n element
+	f.Neg(&n, x)
+	c := f.isNegative(x)
+	*z = *x
+	montgomery.CMove(z, &n, c)
+}
+
+// reverse returns a copy of b in the opposite byte order.
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i, c := range b {
+		r[len(b)-1-i] = c
+	}
+	return r
+}
@@ -0,0 +1,406 @@
+package ed448
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+)
+
+// point is a Decaf448 element, represented by an Ed448 point in extended
+// coordinates (X:Y:Z:T), which stand for the affine point (X/Z, Y/Z) with
+// T = XY/Z. Two points represent the same element if they differ by a point
+// of order 2, as the group is the quotient of the points that are doubles
+// by the 2-torsion. Additions and doublings use the complete formulas of
+// "Twisted Edwards Curves Revisited" by Hisil, Wong, Carter and Dawson
+// (https://eprint.iacr.org/2008/522), which have no exceptional cases on
+// Ed448 and thus no secret-dependent branches.
+type point struct {
+	x, y, z, t element
+}
+
+const pointLen = 56
+
+func newAffinePoint(x, y *big.Int) point {
+	P := point{
+		x: baseField.elementOf(x),
+		y: baseField.elementOf(y),
+		z: baseField.one,
+	}
+	baseField.Mul(&P.t, &P.x, &P.y)
+	return P
+}
+
+// Equality test for two Points derived from the same Group, which checks
+// whether x1·y2 = y1·x2.
+func (P *point) Equal(P2 kyber.Point) bool {
+	Q := P2.(*point)
+	var a, b element
+	baseField.Mul(&a, &P.x, &Q.y)
+	baseField.Mul(&b, &P.y, &Q.x)
+	return montgomery.Equal(&a, &b) == 1
+}
+
+// Null sets P to the identity element.
+func (P *point) Null() kyber.Point {
+	P.x = element{}
+	P.y = baseField.one
+	P.z = baseField.one
+	P.t = element{}
+	return P
+}
+
+// Base sets P to the generator of Decaf448 of RFC 9496, which is the element
+// of twice the Ed448 base point.
+func (P *point) Base() kyber.Point {
+	*P = basePoint
+	return P
+}
+
+// Pick sets P to an element chosen uniformly at random.
+func (P *point) Pick(rand cipher.Stream) kyber.Point {
+	return P.Embed(nil, rand)
+}
+
+// Set P equal to another point.
+func (P *point) Set(P2 kyber.Point) kyber.Point {
+	*P = *P2.(*point)
+	return P
+}
+
+// Clone returns a duplicate of P.
+func (P *point) Clone() kyber.Point {
+	P2 := *P
+	return &P2
+}
+
+// EmbedLen returns the number of bytes of data that can be embedded in an
+// element: the encoding keeps 8 random bits at the top, and 8 bits at the
+// bottom for the length of the data, whose lowest bit must be zero for the
+// encoding to be valid.
+func (P *point) EmbedLen() int {
+	return (448 - 8 - 8) / 8
+}
+
+// Embed sets P to an element whose encoding contains the given data, with
+// the remaining bits chosen at random.
+func (P *point) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	dl := P.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		// Every valid encoding is a distinct element of the prime-order
+		// group, so a random encoding that decodes is a uniform element.
+		var b [pointLen]byte
+		rand.XORKeyStream(b[:], b[:])
+		b[0] &^= 1 // Keep the value non-negative
+		if data != nil {
+			b[0] = byte(dl) << 1  // Encode length in low 8 bits
+			copy(b[1:1+dl], data) // Copy in data to embed
+		}
+		if P.decode(b[:]) {
+			return P
+		}
+	}
+}
+
+// Data extracts the data embedded in P with Embed.
+func (P *point) Data() ([]byte, error) {
+	var b [pointLen]byte
+	P.encode(&b)
+	dl := int(b[0] >> 1)
+	if dl > P.EmbedLen() {
+		return nil, errors.New("ed448: invalid embedded data length")
+	}
+	return b[1 : 1+dl], nil
+}
+
+// Add sets P to the sum of P1 and P2.
+func (P *point) Add(P1, P2 kyber.Point) kyber.Point {
+	P.add(P1.(*point), P2.(*point))
+	return P
+}
+
+// Sub sets P to P1 - P2.
+func (P *point) Sub(P1, P2 kyber.Point) kyber.Point {
+	var Q point
+	Q.Neg(P2)
+	P.add(P1.(*point), &Q)
+	return P
+}
+
+// Neg sets P to the negation of A.
+func (P *point) Neg(A kyber.Point) kyber.Point {
+	Q := A.(*point)
+	baseField.Neg(&P.x, &Q.x)
+	P.y = Q.y
+	P.z = Q.z
+	baseField.Neg(&P.t, &Q.t)
+	return P
+}
+
+// Mul sets P to s times A, or s times the base point if A is nil, in
+// constant time.
+func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
+	Q := &basePoint
+	if A != nil {
+		Q = A.(*point)
+	}
+	P.mul(&s.(*scalar).v, Q)
+	return P
+}
+
+// Valid returns true, since every Decaf448 element is a multiple of the
+// generator.
+func (P *point) Valid() bool {
+	return true
+}
+
+// IsSmallOrder reports whether P is the identity element, the only element
+// of small order of Decaf448.
+func (P *point) IsSmallOrder() bool {
+	var O point
+	return P.Equal(O.Null())
+}
+
+// ClearCofactor sets P to A, since Decaf448 has prime order.
+func (P *point) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Set(A)
+}
+
+// String returns the hexadecimal encoding of P.
+func (P *point) String() string {
+	var b [pointLen]byte
+	P.encode(&b)
+	return hex.EncodeToString(b[:])
+}
+
+// MarshalSize returns 56, the length of the encoding of elements.
+func (P *point) MarshalSize() int {
+	return pointLen
+}
+
+// MarshalBinary returns the canonical Decaf448 encoding of P.
+func (P *point) MarshalBinary() ([]byte, error) {
+	var b [pointLen]byte
+	P.encode(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary sets P to the element of the given Decaf448 encoding,
+// which must be canonical.
+func (P *point) UnmarshalBinary(b []byte) error {
+	if len(b) != pointLen {
+		return errors.New("ed448: wrong size buffer")
+	}
+	if !P.decode(b) {
+		return errors.New("ed448: invalid Decaf448 encoding")
+	}
+	return nil
+}
+
+// MarshalTo writes the Decaf448 encoding of P to w.
+func (P *point) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+// UnmarshalFrom reads the Decaf448 encoding of P from r.
+func (P *point) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
+
+// encode writes the Decaf448 encoding of P to b, following RFC 9496
+// section 5.3.2.
+func (P *point) encode(b *[pointLen]byte) {
+	f := baseField
+	var u1, u2, tmp, invSqrt, ratio, s element
+
+	f.Add(&u1, &P.x, &P.t)
+	f.Sub(&tmp, &P.x, &P.t)
+	f.Mul(&u1, &u1, &tmp) // u1 = (x + t)(x - t)
+
+	f.Square(&tmp, &P.x)
+	f.Mul(&tmp, &tmp, &oneMinusD)
+	f.Mul(&tmp, &tmp, &u1)
+	sqrtRatio(&invSqrt, &f.one, &tmp)
+
+	f.Mul(&ratio, &invSqrt, &u1)
+	f.Mul(&ratio, &ratio, &sqrtMinusD)
+	f.abs(&ratio, &ratio)
+
+	f.Mul(&u2, &invSqrtMinusD, &ratio)
+	f.Mul(&u2, &u2, &P.z)
+	f.Sub(&u2, &u2, &P.t) // u2 = INVSQRT_MINUS_D ratio z - t
+
+	f.Mul(&s, &oneMinusD, &invSqrt)
+	f.Mul(&s, &s, &P.x)
+	f.Mul(&s, &s, &u2)
+	f.abs(&s, &s)
+	copy(b[:], f.bytes(&s))
+}
+
+// decode sets P to the element encoded in b, and reports whether b was a
+// valid canonical Decaf448 encoding, following RFC 9496 section 5.3.1.
+// P is left unchanged if it was not.
+func (P *point) decode(b []byte) bool {
+	f := baseField
+	var s, ss, u1, u2, tmp, invSqrt, u3 element
+	var x, y, t element
+
+	if len(b) != pointLen || !f.setBytes(&s, b) || f.isNegative(&s) == 1 {
+		return false
+	}
+
+	f.Square(&ss, &s)
+	f.Add(&u1, &f.one, &ss) // u1 = 1 + s^2
+	f.Square(&tmp, &u1)
+	f.Mul(&u2, &curveD, &ss)
+	f.Add(&u2, &u2, &u2)
+	f.Add(&u2, &u2, &u2)
+	f.Sub(&u2, &tmp, &u2) // u2 = u1^2 - 4 d s^2
+
+	f.Mul(&tmp, &tmp, &u2)
+	wasSquare := sqrtRatio(&invSqrt, &f.one, &tmp)
+
+	f.Add(&u3, &s, &s)
+	f.Mul(&u3, &u3, &invSqrt)
+	f.Mul(&u3, &u3, &u1)
+	f.Mul(&u3, &u3, &sqrtMinusD)
+	f.abs(&u3, &u3)
+
+	f.Mul(&x, &u3, &invSqrt)
+	f.Mul(&x, &x, &u2)
+	f.Mul(&x, &x, &invSqrtMinusD)
+
+	f.Sub(&y, &f.one, &ss)
+	f.Mul(&y, &y, &invSqrt)
+	f.Mul(&y, &y, &u1)
+	f.Mul(&t, &x, &y)
+
+	if wasSquare != 1 {
+		return false
+	}
+	P.x, P.y, P.z, P.t = x, y, f.one, t
+	return true
+}
+
+// add sets P = A + B, with the formula add-2008-hwcd for a = 1.
+func (P *point) add(A, B *point) {
+	f := baseField
+	var a, b, c, d, e, ff, g, h element
+	f.Mul(&a, &A.x, &B.x)
+	f.Mul(&b, &A.y, &B.y)
+	f.Mul(&c, &A.t, &B.t)
+	f.Mul(&c, &c, &curveD)
+	f.Mul(&d, &A.z, &B.z)
+	f.Add(&e, &A.x, &A.y)
+	f.Add(&h, &B.x, &B.y)
+	f.Mul(&e, &e, &h)
+	f.Sub(&e, &e, &a)
+	f.Sub(&e, &e, &b)
+	f.Sub(&ff, &d, &c)
+	f.Add(&g, &d, &c)
+	f.Sub(&h, &b, &a)
+	f.Mul(&P.x, &e, &ff)
+	f.Mul(&P.y, &g, &h)
+	f.Mul(&P.t, &e, &h)
+	f.Mul(&P.z, &ff, &g)
+}
+
+// double sets P = 2A, with the formula dbl-2008-hwcd for a = 1.
+func (P *point) double(A *point) {
+	f := baseField
+	var a, b, c, e, ff, g, h element
+	f.Square(&a, &A.x)
+	f.Square(&b, &A.y)
+	f.Square(&c, &A.z)
+	f.Add(&c, &c, &c)
+	f.Add(&e, &A.x, &A.y)
+	f.Square(&e, &e)
+	f.Sub(&e, &e, &a)
+	f.Sub(&e, &e, &b)
+	f.Add(&g, &a, &b)
+	f.Sub(&ff, &g, &c)
+	f.Sub(&h, &a, &b)
+	f.Mul(&P.x, &e, &ff)
+	f.Mul(&P.y, &g, &h)
+	f.Mul(&P.t, &e, &h)
+	f.Mul(&P.z, &ff, &g)
+}
+
+// mul sets P = kA, in constant time.
+func (P *point) mul(k *element, A *point) {
+	// Fixed 4-bit windows over the encoding of k, from the most
+	// significant one, with the multiples of A selected in constant time.
+	var table [16]point
+	table[0].Null()
+	table[1] = *A
+	for i := 2; i < 16; i += 2 {
+		table[i].double(&table[i/2])
+		table[i+1].add(&table[i], A)
+	}
+
+	b := scalarField.bytes(k)
+	var R, T point
+	R.Null()
+	for i := len(b) - 1; i >= 0; i-- {
+		for _, w := range []byte{b[i] >> 4, b[i] & 15} {
+			R.double(&R)
+			R.double(&R)
+			R.double(&R)
+			R.double(&R)
+			T.selectPoint(&table, w)
+			R.add(&R, &T)
+		}
+	}
+	*P = R
+}
+
+// selectPoint sets P to table[i] without leaking i through memory accesses.
+func (P *point) selectPoint(table *[16]point, i byte) {
+	P.Null()
+	for j := range table {
+		c := montgomery.IsZeroWord(uint64(i) ^ uint64(j))
+		montgomery.CMove(&P.x, &table[j].x, c)
+		montgomery.CMove(&P.y, &table[j].y, c)
+		montgomery.CMove(&P.z, &table[j].z, c)
+		montgomery.CMove(&P.t, &table[j].t, c)
+	}
+}
+
+// sqrtRatio sets r to the non-negative square root of u/v and returns 1 if
+// it exists. Otherwise, it sets r to the non-negative square root of -u/v
+// and returns 0. Since p = 3 mod 4, r = u(uv)^((p-3)/4) is one of them.
+func sqrtRatio(r, u, v *element) uint64 {
+	f := baseField
+	var uv, check element
+	f.Mul(&uv, u, v)
+	f.Exp(r, &uv, pMinus3Over4)
+	f.Mul(r, r, u)
+	f.Square(&check, r)
+	f.Mul(&check, &check, v)
+	f.abs(r, r)
+	return montgomery.Equal(&check, u)
+}
+
+// sqrt returns the non-negative square root of the square n.
+func sqrt(n int64) element {
+	var r element
+	a := baseField.elementOf(big.NewInt(n))
+	sqrtRatio(&r, &a, &baseField.one)
+	return r
+}
+
+// inverse returns 1/x.
+func inverse(x element) element {
+	var r element
+	baseField.Inv(&r, &x)
+	return r
+}
@@ -0,0 +1,201 @@
+package ed448
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
+)
+
+const scalarLen = 56
+
+// Exponents of the square roots and of Euler's criterion modulo l.
+var (
+	lPlus1Over4  = new(big.Int).Rsh(new(big.Int).Add(l, big.NewInt(1)), 2)
+	lMinus1Over2 = new(big.Int).Rsh(new(big.Int).Sub(l, big.NewInt(1)), 1)
+)
+
+// scalar is an integer modulo the order l of the group, encoded as a
+// 56-byte little-endian integer.
+type scalar struct {
+	v element
+}
+
+// Equality test for two Scalars derived from the same Group
+func (s *scalar) Equal(s2 kyber.Scalar) bool {
+	return montgomery.Equal(&s.v, &s2.(*scalar).v) == 1
+}
+
+// Set equal to another Scalar a
+func (s *scalar) Set(a kyber.Scalar) kyber.Scalar {
+	s.v = a.(*scalar).v
+	return s
+}
+
+// Clone returns a duplicate of the scalar s.
+func (s *scalar) Clone() kyber.Scalar {
+	s2 := *s
+	return &s2
+}
+
+// SetInt64 sets the scalar to a small integer value.
+func (s *scalar) SetInt64(v int64) kyber.Scalar {
+	s.v = scalarField.elementOf(big.NewInt(v))
+	return s
+}
+
+// Set to the additive identity (0)
+func (s *scalar) Zero() kyber.Scalar {
+	s.v = element{}
+	return s
+}
+
+// Set to the multiplicative identity (1)
+func (s *scalar) One() kyber.Scalar {
+	s.v = scalarField.one
+	return s
+}
+
+// Set to the modular sum of scalars a and b
+func (s *scalar) Add(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Add(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular difference a - b
+func (s *scalar) Sub(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Sub(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular negation of scalar a
+func (s *scalar) Neg(a kyber.Scalar) kyber.Scalar {
+	scalarField.Neg(&s.v, &a.(*scalar).v)
+	return s
+}
+
+// Set to the modular product of scalars a and b
+func (s *scalar) Mul(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Mul(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular division of scalar a by scalar b
+func (s *scalar) Div(a, b kyber.Scalar) kyber.Scalar {
+	var i element
+	scalarField.Inv(&i, &b.(*scalar).v)
+	scalarField.Mul(&s.v, &a.(*scalar).v, &i)
+	return s
+}
+
+// Set to the modular inverse of scalar a
+func (s *scalar) Inv(a kyber.Scalar) kyber.Scalar {
+	scalarField.Inv(&s.v, &a.(*scalar).v)
+	return s
+}
+
+// Set to a fresh random or pseudo-random scalar
+func (s *scalar) Pick(rand cipher.Stream) kyber.Scalar {
+	s.v = scalarField.elementOf(random.Int(l, rand))
+	return s
+}
+
+// SetBytes sets s to b, interpreted as a little endian integer of any
+// length and reduced modulo l.
+func (s *scalar) SetBytes(b []byte) kyber.Scalar {
+	scalarField.setBytesWide(&s.v, b)
+	return s
+}
+
+// SetBytesWide sets s to b, interpreted as a little endian integer of any
+// length and reduced modulo l, such as a 64-byte hash.
+func (s *scalar) SetBytesWide(b []byte) kyber.Scalar {
+	return s.SetBytes(b)
+}
+
+// Exp sets s to a^e, in time that only depends on the public exponent e.
+// Negative exponents raise 1/a.
+func (s *scalar) Exp(a kyber.Scalar, e *big.Int) kyber.Scalar {
+	x := a.(*scalar).v
+	if e.Sign() < 0 {
+		scalarField.Inv(&x, &x)
+		e = new(big.Int).Neg(e)
+	}
+	scalarField.Exp(&s.v, &x, e)
+	return s
+}
+
+// Sqrt sets s to a square root of a and returns true if a is a square, or
+// returns false and leaves s unchanged otherwise. Since l = 3 mod 4, the
+// candidate root is a^((l+1)/4), and the running time does not depend on a.
+func (s *scalar) Sqrt(a kyber.Scalar) bool {
+	x := &a.(*scalar).v
+	var r, r2 element
+	scalarField.Exp(&r, x, lPlus1Over4)
+	scalarField.Square(&r2, &r)
+	if montgomery.Equal(&r2, x) == 0 {
+		return false
+	}
+	s.v = r
+	return true
+}
+
+// Legendre returns the Legendre symbol of s modulo l, computed as
+// s^((l-1)/2) by Euler's criterion.
+func (s *scalar) Legendre() int {
+	var r element
+	scalarField.Exp(&r, &s.v, lMinus1Over2)
+	switch {
+	case montgomery.Equal(&r, &scalarField.one) == 1:
+		return 1
+	case montgomery.Equal(&s.v, &element{}) == 1:
+		return 0
+	}
+	return -1
+}
+
+// String returns the string representation of this scalar (fixed length of
+// 56 bytes, little endian).
+func (s *scalar) String() string {
+	return hex.EncodeToString(scalarField.bytes(&s.v))
+}
+
+// Encoded length of this object in bytes.
+func (s *scalar) MarshalSize() int {
+	return scalarLen
+}
+
+// MarshalBinary returns the binary representation of this scalar.
+func (s *scalar) MarshalBinary() ([]byte, error) {
+	return scalarField.bytes(&s.v), nil
+}
+
+// UnmarshalBinary reads the binary representation of a scalar, which must
+// be reduced modulo l.
+func (s *scalar) UnmarshalBinary(buf []byte) error {
+	if len(buf) != scalarLen {
+		return errors.New("ed448: wrong size buffer")
+	}
+	if !scalarField.setBytes(&s.v, buf) {
+		return errors.New("ed448: scalar is not reduced modulo l")
+	}
+	return nil
+}
+
+// MarshalTo writes the binary representation of this scalar to the given
+// writer.
+func (s *scalar) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.ScalarMarshalTo(s, w)
+}
+
+// UnmarshalFrom reads the binary representation of a scalar from the given
+// reader.
+func (s *scalar) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.ScalarUnmarshalFrom(s, r)
+}
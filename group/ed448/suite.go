@@ -0,0 +1,71 @@
+package ed448
+
+import (
+	"crypto/cipher"
+	"crypto/sha512"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/keccak"
+)
+
+// SuiteEd448 implements some basic functionalities such as Group,
+// HashFactory, and XOFFactory.
+type SuiteEd448 struct {
+	Curve
+	r cipher.Stream
+}
+
+// Hash returns a newly instanciated sha512 hash function.
+func (s *SuiteEd448) Hash() hash.Hash {
+	return sha512.New()
+}
+
+// XOF returns an XOF which is implemented via the Shake256 hash.
+func (s *SuiteEd448) XOF(key []byte) kyber.XOF {
+	return keccak.New(key)
+}
+
+func (s *SuiteEd448) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteEd448) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+// New implements the kyber.Encoding interface
+func (s *SuiteEd448) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *SuiteEd448) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+// NewShakeSHA512Ed448 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/keccak, SHA-512, and the Decaf448 group.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewShakeSHA512Ed448() *SuiteEd448 {
+	suite := new(SuiteEd448)
+	return suite
+}
+
+// NewShakeSHA512Ed448WithRand returns a cipher suite based on package
+// github.com/dedis/kyber/xof/keccak, SHA-512, and the Decaf448 group.
+// It produces cryptographically random numbers via the provided stream r.
+func NewShakeSHA512Ed448WithRand(r cipher.Stream) *SuiteEd448 {
+	suite := new(SuiteEd448)
+	suite.r = r
+	return suite
+}
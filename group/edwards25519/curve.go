@@ -3,6 +3,7 @@ package edwards25519
 import (
 	"crypto/cipher"
 	"crypto/sha512"
+	"math/big"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/util/random"
@@ -72,3 +73,20 @@ func (c *Curve) ScalarFromBytes(b []byte) kyber.Scalar {
 	sc.UnmarshalBinary(b)
 	return sc
 }
+
+// Order returns the order l of the base point, which is the modulus of the
+// Scalars: 2^252 + 27742317777372353535851937790883648493.
+func (c *Curve) Order() *big.Int {
+	return new(big.Int).Set(primeOrder)
+}
+
+// Cofactor returns 8, the cofactor of the Ed25519 curve.
+func (c *Curve) Cofactor() *big.Int {
+	return new(big.Int).Set(cofactor)
+}
+
+// IsPrimeOrder returns false: points of small order are accepted when
+// decoding, so that the group of Points is not of prime order.
+func (c *Curve) IsPrimeOrder() bool {
+	return false
+}
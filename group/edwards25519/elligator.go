@@ -53,15 +53,15 @@ func PublicKeyToCurve25519(curve25519Public *[32]byte, publicKey *[32]byte) bool
 	return true
 }
 
-// sqrtMinusA is sqrt(-486662)
-var sqrtMinusA = fieldElement{
+// sqrtMinusA is a square root of -(A+2) = -486664, the odd one.
+var sqrtMinusA = fromFE10(fe10{
 	12222970, 8312128, 11511410, -9067497, 15300785, 241793, -25456130, -14121551, 12187136, -3972024,
-}
+})
 
 // sqrtMinusHalf is sqrt(-1/2)
-var sqrtMinusHalf = fieldElement{
+var sqrtMinusHalf = fromFE10(fe10{
 	-17256545, 3971863, 28865457, -1750208, 27359696, -16640980, 12573105, 1002827, -163343, 11073975,
-}
+})
 
 // halfQMinus1Bytes is (2^255-20)/2 expressed in little endian form.
 var halfQMinus1Bytes = [32]byte{
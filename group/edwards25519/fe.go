@@ -11,36 +11,39 @@ import (
 // This code is a port of the public domain, "ref10" implementation of ed25519
 // from SUPERCOP.
 
-// fieldElement represents an element of the field GF(2^255 - 19).  An element
+// fe10 represents an element of the field GF(2^255 - 19).  An element
 // t, entries t[0]...t[9], represents the integer t[0]+2^26 t[1]+2^51 t[2]+2^77
 // t[3]+2^102 t[4]+...+2^230 t[9].  Bounds on each t[i] vary depending on
 // context.
-type fieldElement [10]int32
+//
+// fieldElement is fe10 on 32-bit platforms, and the constants of the package
+// are written in this representation on all platforms.
+type fe10 [10]int32
 
-func feZero(fe *fieldElement) {
+func fe10Zero(fe *fe10) {
 	for i := range fe {
 		fe[i] = 0
 	}
 }
 
-func feOne(fe *fieldElement) {
-	feZero(fe)
+func fe10One(fe *fe10) {
+	fe10Zero(fe)
 	fe[0] = 1
 }
 
-func feAdd(dst, a, b *fieldElement) {
+func fe10Add(dst, a, b *fe10) {
 	for i := range dst {
 		dst[i] = a[i] + b[i]
 	}
 }
 
-func feSub(dst, a, b *fieldElement) {
+func fe10Sub(dst, a, b *fe10) {
 	for i := range dst {
 		dst[i] = a[i] - b[i]
 	}
 }
 
-func feCopy(dst, src *fieldElement) {
+func fe10Copy(dst, src *fe10) {
 	for i := range dst {
 		dst[i] = src[i]
 	}
@@ -50,8 +53,8 @@ func feCopy(dst, src *fieldElement) {
 // replace (f,g) with (f,g) if b == 0.
 //
 // Preconditions: b in {0,1}.
-func feCMove(f, g *fieldElement, b int32) {
-	var x fieldElement
+func fe10CMove(f, g *fe10, b int32) {
+	var x fe10
 	b = -b
 	for i := range x {
 		x[i] = b & (f[i] ^ g[i])
@@ -76,7 +79,7 @@ func load4(in []byte) int64 {
 	return r
 }
 
-func feFromBytes(dst *fieldElement, src []byte) {
+func fe10FromBytes(dst *fe10, src []byte) {
 	h0 := load4(src[:])
 	h1 := load3(src[4:]) << 6
 	h2 := load3(src[7:]) << 5
@@ -133,7 +136,7 @@ func feFromBytes(dst *fieldElement, src []byte) {
 	dst[9] = int32(h9)
 }
 
-// feToBytes marshals h to s.
+// fe10ToBytes marshals h to s.
 // Preconditions:
 //   |h| bounded by 1.1*2^25,1.1*2^24,1.1*2^25,1.1*2^24,etc.
 //
@@ -156,7 +159,7 @@ func feFromBytes(dst *fieldElement, src []byte) {
 //
 //   Have q+2^(-255)x = 2^(-255)(h + 19 2^(-25) h9 + 2^(-1))
 //   so floor(2^(-255)(h + 19 2^(-25) h9 + 2^(-1))) = q.
-func feToBytes(s *[32]byte, h *fieldElement) {
+func fe10ToBytes(s *[32]byte, h *fe10) {
 	var carry [10]int32
 
 	q := (19*h[9] + (1 << 24)) >> 25
@@ -245,15 +248,15 @@ func feToBytes(s *[32]byte, h *fieldElement) {
 	s[31] = byte(h[9] >> 18)
 }
 
-func feIsNegative(f *fieldElement) byte {
+func fe10IsNegative(f *fe10) byte {
 	var s [32]byte
-	feToBytes(&s, f)
+	fe10ToBytes(&s, f)
 	return s[0] & 1
 }
 
-func feIsNonZero(f *fieldElement) int32 {
+func fe10IsNonZero(f *fe10) int32 {
 	var s [32]byte
-	feToBytes(&s, f)
+	fe10ToBytes(&s, f)
 	var x uint8
 	for _, b := range s {
 		x |= b
@@ -264,20 +267,20 @@ func feIsNonZero(f *fieldElement) int32 {
 	return int32(x & 1)
 }
 
-// feNeg sets h = -f
+// fe10Neg sets h = -f
 //
 // Preconditions:
 //    |f| bounded by 1.1*2^25,1.1*2^24,1.1*2^25,1.1*2^24,etc.
 //
 // Postconditions:
 //    |h| bounded by 1.1*2^25,1.1*2^24,1.1*2^25,1.1*2^24,etc.
-func feNeg(h, f *fieldElement) {
+func fe10Neg(h, f *fe10) {
 	for i := range h {
 		h[i] = -f[i]
 	}
 }
 
-// feMul calculates h = f * g
+// fe10Mul calculates h = f * g
 // Can overlap h with f or g.
 //
 // Preconditions:
@@ -304,7 +307,7 @@ func feNeg(h, f *fieldElement) {
 // Can get away with 11 carries, but then data flow is much deeper.
 //
 // With tighter constraints on inputs can squeeze carries into int32.
-func feMul(h, f, g *fieldElement) {
+func fe10Mul(h, f, g *fe10) {
 	f0 := f[0]
 	f1 := f[1]
 	f2 := f[2]
@@ -537,14 +540,14 @@ func feMul(h, f, g *fieldElement) {
 	h[9] = int32(h9)
 }
 
-// feSquare calculates h = f*f. Can overlap h with f.
+// fe10Square calculates h = f*f. Can overlap h with f.
 //
 // Preconditions:
 //    |f| bounded by 1.1*2^26,1.1*2^25,1.1*2^26,1.1*2^25,etc.
 //
 // Postconditions:
 //    |h| bounded by 1.1*2^25,1.1*2^24,1.1*2^25,1.1*2^24,etc.
-func feSquare(h, f *fieldElement) {
+func fe10Square(h, f *fe10) {
 	f0 := f[0]
 	f1 := f[1]
 	f2 := f[2]
@@ -690,7 +693,7 @@ func feSquare(h, f *fieldElement) {
 	h[9] = int32(h9)
 }
 
-// feSquare2 sets h = 2 * f * f
+// fe10Square2 sets h = 2 * f * f
 //
 // Can overlap h with f.
 //
@@ -700,7 +703,7 @@ func feSquare(h, f *fieldElement) {
 // Postconditions:
 //    |h| bounded by 1.01*2^25,1.01*2^24,1.01*2^25,1.01*2^24,etc.
 // See fe_mul.c for discussion of implementation strategy.
-func feSquare2(h, f *fieldElement) {
+func fe10Square2(h, f *fe10) {
 	f0 := f[0]
 	f1 := f[1]
 	f2 := f[2]
@@ -857,6 +860,7 @@ func feSquare2(h, f *fieldElement) {
 	h[9] = int32(h9)
 }
 
+// feInvert sets out = 1/z, or 0 if z is 0, as z^(p-2).
 func feInvert(out, z *fieldElement) {
 	var t0, t1, t2, t3 fieldElement
 	var i int
@@ -912,6 +916,7 @@ func feInvert(out, z *fieldElement) {
 	feMul(out, &t1, &t0) // 254..5,3,1,0
 }
 
+// fePow22523 sets out = z^((p-5)/8).
 func fePow22523(out, z *fieldElement) {
 	var t0, t1, t2 fieldElement
 	var i int
@@ -0,0 +1,260 @@
+package edwards25519
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// fe51 represents an element of the field GF(2^255 - 19) on 64-bit
+// platforms. An element t, entries t[0]...t[4], represents the integer
+// t[0]+2^51 t[1]+2^102 t[2]+2^153 t[3]+2^204 t[4]. The functions below
+// accept limbs below 2^52, and return limbs slightly above 2^51 at most.
+// Products of such limbs fit in the 128-bit accumulators of fe51Mul, which
+// therefore needs no more than two carry chains, instead of the dozen of
+// the 32-bit representation.
+type fe51 [5]uint64
+
+const maskLow51Bits = 1<<51 - 1
+
+func fe51Zero(fe *fe51) {
+	*fe = fe51{}
+}
+
+func fe51One(fe *fe51) {
+	*fe = fe51{1}
+}
+
+// fe51Carry brings the limbs of h slightly above 2^51 at most, by moving
+// their bits above 2^51 to the next limb, and the ones of the top limb to
+// the bottom one times 19, since 2^255 = 19 mod p.
+func fe51Carry(h *fe51) {
+	c0 := h[0] >> 51
+	c1 := h[1] >> 51
+	c2 := h[2] >> 51
+	c3 := h[3] >> 51
+	c4 := h[4] >> 51
+	h[0] = h[0]&maskLow51Bits + c4*19
+	h[1] = h[1]&maskLow51Bits + c0
+	h[2] = h[2]&maskLow51Bits + c1
+	h[3] = h[3]&maskLow51Bits + c2
+	h[4] = h[4]&maskLow51Bits + c3
+}
+
+func fe51Add(dst, a, b *fe51) {
+	dst[0] = a[0] + b[0]
+	dst[1] = a[1] + b[1]
+	dst[2] = a[2] + b[2]
+	dst[3] = a[3] + b[3]
+	dst[4] = a[4] + b[4]
+	fe51Carry(dst)
+}
+
+// fe51Sub sets dst = a - b, computed as a + 2p - b so that no limb goes
+// negative.
+func fe51Sub(dst, a, b *fe51) {
+	dst[0] = (a[0] + 0xfffffffffffda) - b[0]
+	dst[1] = (a[1] + 0xffffffffffffe) - b[1]
+	dst[2] = (a[2] + 0xffffffffffffe) - b[2]
+	dst[3] = (a[3] + 0xffffffffffffe) - b[3]
+	dst[4] = (a[4] + 0xffffffffffffe) - b[4]
+	fe51Carry(dst)
+}
+
+func fe51Neg(h, f *fe51) {
+	fe51Sub(h, &fe51{}, f)
+}
+
+func fe51Copy(dst, src *fe51) {
+	*dst = *src
+}
+
+// Replace (f,g) with (g,g) if b == 1;
+// replace (f,g) with (f,g) if b == 0.
+//
+// Preconditions: b in {0,1}.
+func fe51CMove(f, g *fe51, b int32) {
+	mask := -uint64(b)
+	f[0] ^= mask & (f[0] ^ g[0])
+	f[1] ^= mask & (f[1] ^ g[1])
+	f[2] ^= mask & (f[2] ^ g[2])
+	f[3] ^= mask & (f[3] ^ g[3])
+	f[4] ^= mask & (f[4] ^ g[4])
+}
+
+// fe51FromBytes sets dst to the 32-byte little-endian integer src, ignoring
+// its top bit as ref10 does.
+func fe51FromBytes(dst *fe51, src []byte) {
+	dst[0] = binary.LittleEndian.Uint64(src[0:8]) & maskLow51Bits
+	dst[1] = binary.LittleEndian.Uint64(src[6:14]) >> 3 & maskLow51Bits
+	dst[2] = binary.LittleEndian.Uint64(src[12:20]) >> 6 & maskLow51Bits
+	dst[3] = binary.LittleEndian.Uint64(src[19:27]) >> 1 & maskLow51Bits
+	dst[4] = binary.LittleEndian.Uint64(src[24:32]) >> 12 & maskLow51Bits
+}
+
+// fe51ToBytes marshals the canonical representative of h, in [0, p), to s.
+func fe51ToBytes(s *[32]byte, h *fe51) {
+	t := *h
+	fe51Carry(&t)
+
+	// Write t = q*p + r with r < p. Since t < 2p, q is the carry out of
+	// bit 255 of t + 19, and r = t + 19q - 2^255 q.
+	q := (t[0] + 19) >> 51
+	q = (t[1] + q) >> 51
+	q = (t[2] + q) >> 51
+	q = (t[3] + q) >> 51
+	q = (t[4] + q) >> 51
+
+	t[0] += 19 * q
+	t[1] += t[0] >> 51
+	t[0] &= maskLow51Bits
+	t[2] += t[1] >> 51
+	t[1] &= maskLow51Bits
+	t[3] += t[2] >> 51
+	t[2] &= maskLow51Bits
+	t[4] += t[3] >> 51
+	t[3] &= maskLow51Bits
+	t[4] &= maskLow51Bits
+
+	binary.LittleEndian.PutUint64(s[0:8], t[0]|t[1]<<51)
+	binary.LittleEndian.PutUint64(s[8:16], t[1]>>13|t[2]<<38)
+	binary.LittleEndian.PutUint64(s[16:24], t[2]>>26|t[3]<<25)
+	binary.LittleEndian.PutUint64(s[24:32], t[3]>>39|t[4]<<12)
+}
+
+func fe51IsNegative(f *fe51) byte {
+	var s [32]byte
+	fe51ToBytes(&s, f)
+	return s[0] & 1
+}
+
+func fe51IsNonZero(f *fe51) int32 {
+	var s [32]byte
+	fe51ToBytes(&s, f)
+	var x uint8
+	for _, b := range s {
+		x |= b
+	}
+	x |= x >> 4
+	x |= x >> 2
+	x |= x >> 1
+	return int32(x & 1)
+}
+
+// uint128 holds a 128-bit accumulator of fe51MulGeneric.
+type uint128 struct {
+	lo, hi uint64
+}
+
+// mul64 returns a*b.
+func mul64(a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	return uint128{lo, hi}
+}
+
+// addMul64 returns v + a*b.
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+// shiftRightBy51 returns a >> 51, which fits in 64 bits for the
+// accumulators of fe51MulGeneric.
+func shiftRightBy51(a uint128) uint64 {
+	return a.hi<<13 | a.lo>>51
+}
+
+// fe51Reduce sets h to the sum of r[i] 2^(51i), reducing the accumulators
+// with two carry chains, the first of which is done in 128 bits.
+func fe51Reduce(h *fe51, r0, r1, r2, r3, r4 uint128) {
+	c0 := shiftRightBy51(r0)
+	c1 := shiftRightBy51(r1)
+	c2 := shiftRightBy51(r2)
+	c3 := shiftRightBy51(r3)
+	c4 := shiftRightBy51(r4)
+	h[0] = r0.lo&maskLow51Bits + c4*19
+	h[1] = r1.lo&maskLow51Bits + c0
+	h[2] = r2.lo&maskLow51Bits + c1
+	h[3] = r3.lo&maskLow51Bits + c2
+	h[4] = r4.lo&maskLow51Bits + c3
+	fe51Carry(h)
+}
+
+// fe51MulGeneric sets h = f * g, with schoolbook multiplication where the
+// products of limbs that overflow 2^255 are folded back times 19. It is the
+// portable version of fe51Mul. Can overlap h with f or g.
+func fe51MulGeneric(h, f, g *fe51) {
+	f0, f1, f2, f3, f4 := f[0], f[1], f[2], f[3], f[4]
+	g0, g1, g2, g3, g4 := g[0], g[1], g[2], g[3], g[4]
+	f1_19, f2_19, f3_19, f4_19 := f1*19, f2*19, f3*19, f4*19
+
+	r0 := mul64(f0, g0)
+	r0 = addMul64(r0, f1_19, g4)
+	r0 = addMul64(r0, f2_19, g3)
+	r0 = addMul64(r0, f3_19, g2)
+	r0 = addMul64(r0, f4_19, g1)
+
+	r1 := mul64(f0, g1)
+	r1 = addMul64(r1, f1, g0)
+	r1 = addMul64(r1, f2_19, g4)
+	r1 = addMul64(r1, f3_19, g3)
+	r1 = addMul64(r1, f4_19, g2)
+
+	r2 := mul64(f0, g2)
+	r2 = addMul64(r2, f1, g1)
+	r2 = addMul64(r2, f2, g0)
+	r2 = addMul64(r2, f3_19, g4)
+	r2 = addMul64(r2, f4_19, g3)
+
+	r3 := mul64(f0, g3)
+	r3 = addMul64(r3, f1, g2)
+	r3 = addMul64(r3, f2, g1)
+	r3 = addMul64(r3, f3, g0)
+	r3 = addMul64(r3, f4_19, g4)
+
+	r4 := mul64(f0, g4)
+	r4 = addMul64(r4, f1, g3)
+	r4 = addMul64(r4, f2, g2)
+	r4 = addMul64(r4, f3, g1)
+	r4 = addMul64(r4, f4, g0)
+
+	fe51Reduce(h, r0, r1, r2, r3, r4)
+}
+
+// fe51SquareGeneric sets h = f*f, sharing the symmetric products. It is
+// the portable version of fe51Square. Can overlap h with f.
+func fe51SquareGeneric(h, f *fe51) {
+	f0, f1, f2, f3, f4 := f[0], f[1], f[2], f[3], f[4]
+	f0_2, f1_2 := f0*2, f1*2
+	f1_38, f2_38, f3_38 := f1*38, f2*38, f3*38
+	f3_19, f4_19 := f3*19, f4*19
+
+	r0 := mul64(f0, f0)
+	r0 = addMul64(r0, f1_38, f4)
+	r0 = addMul64(r0, f2_38, f3)
+
+	r1 := mul64(f0_2, f1)
+	r1 = addMul64(r1, f2_38, f4)
+	r1 = addMul64(r1, f3_19, f3)
+
+	r2 := mul64(f0_2, f2)
+	r2 = addMul64(r2, f1, f1)
+	r2 = addMul64(r2, f3_38, f4)
+
+	r3 := mul64(f0_2, f3)
+	r3 = addMul64(r3, f1_2, f2)
+	r3 = addMul64(r3, f4_19, f4)
+
+	r4 := mul64(f0_2, f4)
+	r4 = addMul64(r4, f1_2, f3)
+	r4 = addMul64(r4, f2, f2)
+
+	fe51Reduce(h, r0, r1, r2, r3, r4)
+}
+
+// fe51Square2 sets h = 2 * f * f.
+func fe51Square2(h, f *fe51) {
+	fe51Square(h, f)
+	fe51Add(h, h, h)
+}
@@ -0,0 +1,11 @@
+package edwards25519
+
+// fe51Mul sets v = x * y, as fe51MulGeneric does.
+//
+//go:noescape
+func fe51Mul(v, x, y *fe51)
+
+// fe51Square sets v = x*x, as fe51SquareGeneric does.
+//
+//go:noescape
+func fe51Square(v, x *fe51)
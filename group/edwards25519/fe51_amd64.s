@@ -0,0 +1,312 @@
+#include "textflag.h"
+
+// func fe51Mul(v *fe51, x *fe51, y *fe51)
+TEXT ·fe51Mul(SB), NOSPLIT, $0-24
+	MOVQ x+8(FP), CX
+	MOVQ y+16(FP), BX
+
+	// r0
+	MOVQ (CX), AX
+	MULQ (BX)
+	MOVQ AX, DI
+	MOVQ DX, SI
+	MOVQ 8(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+	MOVQ 16(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+	MOVQ 24(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 16(BX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+	MOVQ 32(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 8(BX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+
+	// r1
+	MOVQ (CX), AX
+	MULQ 8(BX)
+	MOVQ AX, R8
+	MOVQ DX, R9
+	MOVQ 8(CX), AX
+	MULQ (BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 16(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 24(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 32(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 16(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+
+	// r2
+	MOVQ (CX), AX
+	MULQ 16(BX)
+	MOVQ AX, R10
+	MOVQ DX, R11
+	MOVQ 8(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 16(CX), AX
+	MULQ (BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 24(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 32(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+
+	// r3
+	MOVQ (CX), AX
+	MULQ 24(BX)
+	MOVQ AX, R12
+	MOVQ DX, R13
+	MOVQ 8(CX), AX
+	MULQ 16(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 16(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 24(CX), AX
+	MULQ (BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 32(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+
+	// r4
+	MOVQ (CX), AX
+	MULQ 32(BX)
+	MOVQ AX, R14
+	MOVQ DX, R15
+	MOVQ 8(CX), AX
+	MULQ 24(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 16(CX), AX
+	MULQ 16(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 24(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 32(CX), AX
+	MULQ (BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+
+	// First reduction chain, on the 128-bit accumulators.
+	MOVQ $0x0007ffffffffffff, AX
+	SHLQ $0x0d, DI, SI
+	ANDQ AX, DI
+	SHLQ $0x0d, R8, R9
+	ANDQ AX, R8
+	SHLQ $0x0d, R10, R11
+	ANDQ AX, R10
+	SHLQ $0x0d, R12, R13
+	ANDQ AX, R12
+	SHLQ $0x0d, R14, R15
+	ANDQ AX, R14
+	IMUL3Q $0x13, R15, R15
+	ADDQ R15, DI
+	ADDQ SI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+
+	// Second reduction chain, as in fe51Carry.
+	MOVQ DI, SI
+	SHRQ $0x33, SI
+	ANDQ AX, DI
+	MOVQ R8, R9
+	SHRQ $0x33, R9
+	ANDQ AX, R8
+	MOVQ R10, R11
+	SHRQ $0x33, R11
+	ANDQ AX, R10
+	MOVQ R12, R13
+	SHRQ $0x33, R13
+	ANDQ AX, R12
+	MOVQ R14, R15
+	SHRQ $0x33, R15
+	ANDQ AX, R14
+	IMUL3Q $0x13, R15, R15
+	ADDQ R15, DI
+	ADDQ SI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+
+	MOVQ v+0(FP), AX
+	MOVQ DI, (AX)
+	MOVQ R8, 8(AX)
+	MOVQ R10, 16(AX)
+	MOVQ R12, 24(AX)
+	MOVQ R14, 32(AX)
+	RET
+
+// func fe51Square(v *fe51, x *fe51)
+TEXT ·fe51Square(SB), NOSPLIT, $0-16
+	MOVQ x+8(FP), CX
+
+	// r0
+	MOVQ (CX), AX
+	MULQ (CX)
+	MOVQ AX, DI
+	MOVQ DX, SI
+	MOVQ 8(CX), AX
+	IMUL3Q $0x26, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+	MOVQ 16(CX), AX
+	IMUL3Q $0x26, AX, AX
+	MULQ 24(CX)
+	ADDQ AX, DI
+	ADCQ DX, SI
+
+	// r1
+	MOVQ (CX), AX
+	SHLQ $0x01, AX
+	MULQ 8(CX)
+	MOVQ AX, R8
+	MOVQ DX, R9
+	MOVQ 16(CX), AX
+	IMUL3Q $0x26, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 24(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 24(CX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+
+	// r2
+	MOVQ (CX), AX
+	SHLQ $0x01, AX
+	MULQ 16(CX)
+	MOVQ AX, R10
+	MOVQ DX, R11
+	MOVQ 8(CX), AX
+	MULQ 8(CX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 24(CX), AX
+	IMUL3Q $0x26, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+
+	// r3
+	MOVQ (CX), AX
+	SHLQ $0x01, AX
+	MULQ 24(CX)
+	MOVQ AX, R12
+	MOVQ DX, R13
+	MOVQ 8(CX), AX
+	SHLQ $0x01, AX
+	MULQ 16(CX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 32(CX), AX
+	IMUL3Q $0x13, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+
+	// r4
+	MOVQ (CX), AX
+	SHLQ $0x01, AX
+	MULQ 32(CX)
+	MOVQ AX, R14
+	MOVQ DX, R15
+	MOVQ 8(CX), AX
+	SHLQ $0x01, AX
+	MULQ 24(CX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 16(CX), AX
+	MULQ 16(CX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+
+	// First reduction chain, on the 128-bit accumulators.
+	MOVQ $0x0007ffffffffffff, AX
+	SHLQ $0x0d, DI, SI
+	ANDQ AX, DI
+	SHLQ $0x0d, R8, R9
+	ANDQ AX, R8
+	SHLQ $0x0d, R10, R11
+	ANDQ AX, R10
+	SHLQ $0x0d, R12, R13
+	ANDQ AX, R12
+	SHLQ $0x0d, R14, R15
+	ANDQ AX, R14
+	IMUL3Q $0x13, R15, R15
+	ADDQ R15, DI
+	ADDQ SI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+
+	// Second reduction chain, as in fe51Carry.
+	MOVQ DI, SI
+	SHRQ $0x33, SI
+	ANDQ AX, DI
+	MOVQ R8, R9
+	SHRQ $0x33, R9
+	ANDQ AX, R8
+	MOVQ R10, R11
+	SHRQ $0x33, R11
+	ANDQ AX, R10
+	MOVQ R12, R13
+	SHRQ $0x33, R13
+	ANDQ AX, R12
+	MOVQ R14, R15
+	SHRQ $0x33, R15
+	ANDQ AX, R14
+	IMUL3Q $0x13, R15, R15
+	ADDQ R15, DI
+	ADDQ SI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+
+	MOVQ v+0(FP), AX
+	MOVQ DI, (AX)
+	MOVQ R8, 8(AX)
+	MOVQ R10, 16(AX)
+	MOVQ R12, 24(AX)
+	MOVQ R14, 32(AX)
+	RET
@@ -0,0 +1,11 @@
+// +build !amd64
+
+package edwards25519
+
+func fe51Mul(h, f, g *fe51) {
+	fe51MulGeneric(h, f, g)
+}
+
+func fe51Square(h, f *fe51) {
+	fe51SquareGeneric(h, f)
+}
@@ -0,0 +1,27 @@
+// +build !amd64,!arm64,!loong64,!mips64,!mips64le,!ppc64,!ppc64le,!riscv64,!s390x
+
+package edwards25519
+
+// fieldElement is the representation of the elements of GF(2^255 - 19) used
+// by the rest of the package. On 32-bit platforms, it is the fe10 of ref10.
+type fieldElement = fe10
+
+func feZero(fe *fieldElement)                 { fe10Zero(fe) }
+func feOne(fe *fieldElement)                  { fe10One(fe) }
+func feAdd(dst, a, b *fieldElement)           { fe10Add(dst, a, b) }
+func feSub(dst, a, b *fieldElement)           { fe10Sub(dst, a, b) }
+func feCopy(dst, src *fieldElement)           { fe10Copy(dst, src) }
+func feCMove(f, g *fieldElement, b int32)     { fe10CMove(f, g, b) }
+func feFromBytes(dst *fieldElement, s []byte) { fe10FromBytes(dst, s) }
+func feToBytes(s *[32]byte, h *fieldElement)  { fe10ToBytes(s, h) }
+func feIsNegative(f *fieldElement) byte       { return fe10IsNegative(f) }
+func feIsNonZero(f *fieldElement) int32       { return fe10IsNonZero(f) }
+func feNeg(h, f *fieldElement)                { fe10Neg(h, f) }
+func feMul(h, f, g *fieldElement)             { fe10Mul(h, f, g) }
+func feSquare(h, f *fieldElement)             { fe10Square(h, f) }
+func feSquare2(h, f *fieldElement)            { fe10Square2(h, f) }
+
+// fromFE10 converts a constant written in the 32-bit representation.
+func fromFE10(a fe10) fieldElement {
+	return a
+}
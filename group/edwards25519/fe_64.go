@@ -0,0 +1,33 @@
+// +build amd64 arm64 loong64 mips64 mips64le ppc64 ppc64le riscv64 s390x
+
+package edwards25519
+
+// fieldElement is the representation of the elements of GF(2^255 - 19) used
+// by the rest of the package. On 64-bit platforms, it is fe51, whose
+// multiplications use the full 64x64-bit products of the processor.
+type fieldElement = fe51
+
+func feZero(fe *fieldElement)                 { fe51Zero(fe) }
+func feOne(fe *fieldElement)                  { fe51One(fe) }
+func feAdd(dst, a, b *fieldElement)           { fe51Add(dst, a, b) }
+func feSub(dst, a, b *fieldElement)           { fe51Sub(dst, a, b) }
+func feCopy(dst, src *fieldElement)           { fe51Copy(dst, src) }
+func feCMove(f, g *fieldElement, b int32)     { fe51CMove(f, g, b) }
+func feFromBytes(dst *fieldElement, s []byte) { fe51FromBytes(dst, s) }
+func feToBytes(s *[32]byte, h *fieldElement)  { fe51ToBytes(s, h) }
+func feIsNegative(f *fieldElement) byte       { return fe51IsNegative(f) }
+func feIsNonZero(f *fieldElement) int32       { return fe51IsNonZero(f) }
+func feNeg(h, f *fieldElement)                { fe51Neg(h, f) }
+func feMul(h, f, g *fieldElement)             { fe51Mul(h, f, g) }
+func feSquare(h, f *fieldElement)             { fe51Square(h, f) }
+func feSquare2(h, f *fieldElement)            { fe51Square2(h, f) }
+
+// fromFE10 converts a constant written in the 32-bit representation.
+func fromFE10(a fe10) (h fieldElement) {
+	var t fe10
+	var s [32]byte
+	fe10Mul(&t, &a, &fe10{1})
+	fe10ToBytes(&s, &t)
+	fe51FromBytes(&h, s[:])
+	return
+}
@@ -0,0 +1,146 @@
+package edwards25519
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// feTestInputs returns random encodings of field elements, along with the
+// edge cases of the reductions: 0, p-1, p, p+18 and 2^255-1, whose top bit
+// is ignored as by ref10.
+func feTestInputs(n int) [][]byte {
+	edges := [][]byte{
+		make([]byte, 32),
+		{0xec, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+		{0xed, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	in := edges
+	for i := 0; i < n; i++ {
+		b := make([]byte, 32)
+		random.Bytes(b, random.New())
+		in = append(in, b)
+	}
+	return in
+}
+
+// TestFe51 checks the radix-2^51 arithmetic, generic and assembly, against
+// the one of ref10.
+func TestFe51(t *testing.T) {
+	in := feTestInputs(200)
+	for i, a := range in {
+		b := in[(i+1)%len(in)]
+		var a10, b10, r10 fe10
+		var a51, b51, r51, g51 fe51
+		fe10FromBytes(&a10, a)
+		fe10FromBytes(&b10, b)
+		fe51FromBytes(&a51, a)
+		fe51FromBytes(&b51, b)
+
+		var s10, s51 [32]byte
+		fe10ToBytes(&s10, &a10)
+		fe51ToBytes(&s51, &a51)
+		require.Equal(t, s10, s51, "ToBytes %x", a)
+
+		check := func(op string) {
+			fe10ToBytes(&s10, &r10)
+			fe51ToBytes(&s51, &r51)
+			require.Equal(t, s10, s51, "%s %x %x", op, a, b)
+			require.Equal(t, fe10IsNegative(&r10), fe51IsNegative(&r51))
+			require.Equal(t, fe10IsNonZero(&r10), fe51IsNonZero(&r51))
+		}
+
+		fe10Add(&r10, &a10, &b10)
+		fe51Add(&r51, &a51, &b51)
+		check("Add")
+		fe10Sub(&r10, &a10, &b10)
+		fe51Sub(&r51, &a51, &b51)
+		check("Sub")
+		fe10Neg(&r10, &a10)
+		fe51Neg(&r51, &a51)
+		check("Neg")
+
+		fe10Mul(&r10, &a10, &b10)
+		fe51Mul(&r51, &a51, &b51)
+		fe51MulGeneric(&g51, &a51, &b51)
+		require.Equal(t, g51, r51)
+		check("Mul")
+		fe10Square(&r10, &a10)
+		fe51Square(&r51, &a51)
+		fe51SquareGeneric(&g51, &a51)
+		require.Equal(t, g51, r51)
+		check("Square")
+		fe10Square2(&r10, &a10)
+		fe51Square2(&r51, &a51)
+		check("Square2")
+
+		// Multiply results that are not fully reduced, as the group law does.
+		fe10Sub(&r10, &a10, &b10)
+		fe10Mul(&r10, &r10, &r10)
+		fe51Sub(&r51, &a51, &b51)
+		fe51Mul(&r51, &r51, &r51)
+		check("Chain")
+
+		fe10CMove(&a10, &b10, 1)
+		fe51CMove(&a51, &b51, 1)
+		r10, r51 = a10, a51
+		check("CMove")
+	}
+}
+
+func TestFeInvert(t *testing.T) {
+	var one [32]byte
+	one[0] = 1
+	for _, a := range feTestInputs(20)[1:] {
+		var x, inv, r fieldElement
+		var s [32]byte
+		feFromBytes(&x, a)
+		feInvert(&inv, &x)
+		feMul(&r, &x, &inv)
+		feToBytes(&s, &r)
+		if feIsNonZero(&x) == 0 {
+			continue
+		}
+		require.True(t, bytes.Equal(s[:], one[:]), "%x", a)
+	}
+}
+
+// TestFeConstants checks a few of the constants converted from ref10.
+func TestFeConstants(t *testing.T) {
+	var r, minusOne, twoD fieldElement
+	var s1, s2 [32]byte
+
+	feOne(&minusOne)
+	feNeg(&minusOne, &minusOne)
+	feSquare(&r, &sqrtM1)
+	feToBytes(&s1, &r)
+	feToBytes(&s2, &minusOne)
+	require.Equal(t, s2, s1)
+
+	feAdd(&twoD, &d, &d)
+	feToBytes(&s1, &twoD)
+	feToBytes(&s2, &d2)
+	require.Equal(t, s2, s1)
+}
+
+func BenchmarkFeMul(b *testing.B) {
+	var x fieldElement
+	feFromBytes(&x, feTestInputs(1)[4])
+	for i := 0; i < b.N; i++ {
+		feMul(&x, &x, &x)
+	}
+}
+
+func BenchmarkFeSquare(b *testing.B) {
+	var x fieldElement
+	feFromBytes(&x, feTestInputs(1)[4])
+	for i := 0; i < b.N; i++ {
+		feSquare(&x, &x)
+	}
+}
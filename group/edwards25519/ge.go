@@ -340,14 +340,15 @@ func negative(b int32) int32 {
 	return (b >> 31) & 1
 }
 
-func selectPreComputed(t *preComputedGroupElement, pos int32, b int32) {
+func selectPreComputed(t *preComputedGroupElement,
+	table *[32][8]preComputedGroupElement, pos int32, b int32) {
 	var minusT preComputedGroupElement
 	bNegative := negative(b)
 	bAbs := b - (((-bNegative) & b) << 1)
 
 	t.Zero()
 	for i := int32(0); i < 8; i++ {
-		t.CMove(&base[pos][i], equal(bAbs, i+1))
+		t.CMove(&table[pos][i], equal(bAbs, i+1))
 	}
 	minusT.Neg(t)
 	t.CMove(&minusT, bNegative)
@@ -360,6 +361,14 @@ func selectPreComputed(t *preComputedGroupElement, pos int32, b int32) {
 // Preconditions:
 //   a[31] <= 127
 func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
+	geScalarMultTable(h, a, &base)
+}
+
+// geScalarMultTable computes h = a*A in constant time, where table holds
+// the multiples of A laid out like the base point table: table[i][j] is
+// (j+1)*256^i*A.
+func geScalarMultTable(h *extendedGroupElement, a *[32]byte,
+	table *[32][8]preComputedGroupElement) {
 	var e [64]int8
 
 	for i, v := range a {
@@ -382,7 +391,7 @@ func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
 	var t preComputedGroupElement
 	var r completedGroupElement
 	for i := int32(1); i < 64; i += 2 {
-		selectPreComputed(&t, i/2, int32(e[i]))
+		selectPreComputed(&t, table, i/2, int32(e[i]))
 		r.MixedAdd(h, &t)
 		r.ToExtended(h)
 	}
@@ -399,7 +408,7 @@ func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
 	r.ToExtended(h)
 
 	for i := int32(0); i < 64; i += 2 {
-		selectPreComputed(&t, i/2, int32(e[i]))
+		selectPreComputed(&t, table, i/2, int32(e[i]))
 		r.MixedAdd(h, &t)
 		r.ToExtended(h)
 	}
@@ -421,6 +430,65 @@ func selectCached(c *cachedGroupElement, Ai *[8]cachedGroupElement, b int32) {
 	c.CMove(&minusC, bNegative)
 }
 
+// newPreComputedTable returns the table of multiples of A used by
+// geScalarMultTable. The 256 multiples are computed in extended coordinates
+// and then converted to affine ones together, with a single inversion.
+func newPreComputedTable(A *extendedGroupElement) *[32][8]preComputedGroupElement {
+	var t completedGroupElement
+	var r projectiveGroupElement
+	var c cachedGroupElement
+	var ext [32][8]extendedGroupElement
+
+	Ai := *A
+	for i := range ext {
+		ext[i][0] = Ai
+		Ai.ToCached(&c)
+		for j := 1; j < 8; j++ {
+			t.Add(&ext[i][j-1], &c)
+			t.ToExtended(&ext[i][j])
+		}
+
+		// Ai <<= 8
+		Ai.Double(&t)
+		for j := 1; j < 8; j++ {
+			t.ToProjective(&r)
+			r.Double(&t)
+		}
+		t.ToExtended(&Ai)
+	}
+
+	// Montgomery's trick: invert the product of all the Z coordinates, and
+	// recover each inverse from the partial products.
+	var prods [32][8]fieldElement
+	var acc, inv, zInv, x, y fieldElement
+	feOne(&acc)
+	for i := range ext {
+		for j := range ext[i] {
+			prods[i][j] = acc
+			feMul(&acc, &acc, &ext[i][j].Z)
+		}
+	}
+	feInvert(&inv, &acc)
+
+	table := new([32][8]preComputedGroupElement)
+	for i := len(ext) - 1; i >= 0; i-- {
+		for j := len(ext[i]) - 1; j >= 0; j-- {
+			p := &ext[i][j]
+			feMul(&zInv, &inv, &prods[i][j])
+			feMul(&inv, &inv, &p.Z)
+
+			feMul(&x, &p.X, &zInv)
+			feMul(&y, &p.Y, &zInv)
+			q := &table[i][j]
+			feAdd(&q.yPlusX, &y, &x)
+			feSub(&q.yMinusX, &y, &x)
+			feMul(&q.xy2d, &x, &y)
+			feMul(&q.xy2d, &q.xy2d, &d2)
+		}
+	}
+	return table
+}
+
 // geScalarMult computes h = a*B, where
 //   a = a[0]+256*a[1]+...+256^31 a[31]
 //   B is the Ed25519 base point (x,4/5) with x positive.
@@ -438,29 +506,11 @@ func geScalarMult(h *extendedGroupElement, a *[32]byte,
 
 	// Break the exponent into 4-bit nybbles.
 	var e [64]int8
-	for i, v := range a {
-		e[2*i] = int8(v & 15)
-		e[2*i+1] = int8((v >> 4) & 15)
-	}
-	// each e[i] is between 0 and 15 and e[63] is between 0 and 7.
-
-	carry := int8(0)
-	for i := 0; i < 63; i++ {
-		e[i] += carry
-		carry = (e[i] + 8) >> 4
-		e[i] -= carry << 4
-	}
-	e[63] += carry
-	// each e[i] is between -8 and 8.
+	signedNybbles(&e, a)
 
 	// compute cached array of multiples of A from 1A through 8A
 	var Ai [8]cachedGroupElement // A,1A,2A,3A,4A,5A,6A,7A
-	A.ToCached(&Ai[0])
-	for i := 0; i < 7; i++ {
-		t.Add(A, &Ai[i])
-		t.ToExtended(&u)
-		u.ToCached(&Ai[i+1])
-	}
+	cachedMultiples(&Ai, A)
 
 	// special case for exponent nybble i == 63
 	u.Zero()
@@ -487,3 +537,79 @@ func geScalarMult(h *extendedGroupElement, a *[32]byte,
 
 	t.ToExtended(h)
 }
+
+// signedNybbles breaks the exponent a into 64 signed 4-bit nybbles such that
+// a = e[0]+16*e[1]+...+16^63 e[63], with each e[i] between -8 and 8.
+func signedNybbles(e *[64]int8, a *[32]byte) {
+	for i, v := range a {
+		e[2*i] = int8(v & 15)
+		e[2*i+1] = int8((v >> 4) & 15)
+	}
+	// each e[i] is between 0 and 15 and e[63] is between 0 and 7.
+
+	carry := int8(0)
+	for i := 0; i < 63; i++ {
+		e[i] += carry
+		carry = (e[i] + 8) >> 4
+		e[i] -= carry << 4
+	}
+	e[63] += carry
+}
+
+// cachedMultiples computes the cached array of multiples of A from 1A
+// through 8A.
+func cachedMultiples(Ai *[8]cachedGroupElement, A *extendedGroupElement) {
+	var t completedGroupElement
+	var u extendedGroupElement
+
+	A.ToCached(&Ai[0])
+	for i := 0; i < 7; i++ {
+		t.Add(A, &Ai[i])
+		t.ToExtended(&u)
+		u.ToCached(&Ai[i+1])
+	}
+}
+
+// geMultiScalarMult computes h = a[0]*A[0] + ... + a[n-1]*A[n-1] with
+// Straus's method: the four doublings needed for each nybble are shared
+// between all the points, which each only contribute one addition from
+// their own table of cached multiples. Like geScalarMult it runs in
+// constant time, and has the same preconditions on each scalar.
+func geMultiScalarMult(h *extendedGroupElement, a []*[32]byte,
+	A []*extendedGroupElement) {
+
+	var t completedGroupElement
+	var r projectiveGroupElement
+	var c cachedGroupElement
+
+	e := make([][64]int8, len(a))
+	Ai := make([][8]cachedGroupElement, len(A))
+	for j := range a {
+		signedNybbles(&e[j], a[j])
+		cachedMultiples(&Ai[j], A[j])
+	}
+
+	h.Zero()
+	for i := 63; i >= 0; i-- {
+
+		// h <<= 4
+		if i < 63 {
+			h.ToProjective(&r)
+			r.Double(&t)
+			t.ToProjective(&r)
+			r.Double(&t)
+			t.ToProjective(&r)
+			r.Double(&t)
+			t.ToProjective(&r)
+			r.Double(&t)
+			t.ToExtended(h)
+		}
+
+		// Add next nybble of each exponent
+		for j := range e {
+			selectCached(&c, &Ai[j], int32(e[j][i]))
+			t.Add(h, &c)
+			t.ToExtended(h)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package edwards25519
+
+import (
+	"crypto/cipher"
+)
+
+// This file implements the kyber.Hiding interface for edwards25519 points
+// with the Elligator 2 map of RFC 9380, section 6.7.1, using Z = 2 as in the
+// curve25519_XMD:SHA-512_ELL2 suites. A point is represented by a field
+// element r in [0, 2^255), and the map from r back to the Montgomery curve
+// is followed by the rational map to the Edwards curve of section 6.8.2.
+//
+// Elligator only maps onto about half of the points of the whole curve,
+// including its small-order ones, so a string decoding to a point of the
+// prime-order subgroup would be easy to tell from a random one. HideEncode
+// therefore adds a random point of small order before encoding, and
+// HideDecode removes it by multiplying by hideCofactor. Whether a point is
+// encodable only depends on the point, not on this random choice.
+
+// sqrtMinusAPlus2 is the non-negative square root of -(A+2) = -486664,
+// which scales the rational map between the Montgomery and Edwards curves.
+var sqrtMinusAPlus2 = fromFE10(fe10{
+	-12222970, -8312128, -11511410, 9067497, -15300785, -241793, 25456130, 14121551, -12187136, 3972024,
+})
+
+// torsion8 is a point of order 8 of the curve.
+var torsion8 = extendedGroupElement{
+	fromFE10(fe10{21352778, 5345713, 4660180, -8347857, 24143090, 14568123, 30185756, -12247770, -33528939, 8345319}),
+	fromFE10(fe10{6952922, 1265500, -6862341, 7057498, 4037696, 5447722, -31680899, 15325402, 19365852, -1569102}),
+	fromFE10(fe10{1, 0, 0, 0, 0, 0, 0, 0, 0, 0}),
+	fromFE10(fe10{-25262188, -11972680, 11716002, -5869612, -18193162, 16297739, 20670665, -8559098, 3541543, -5011181}),
+}
+
+// hideCofactor is 3l+1 in little endian form, where l is the order of the
+// base point. It is 1 modulo l and 0 modulo 8, so multiplying by it keeps
+// the prime-order component of a point and clears its small-order one.
+var hideCofactor = [32]byte{
+	0xc8, 0x7b, 0xe1, 0x16, 0x4f, 0x29, 0x37, 0x08, 0x83, 0xd6, 0xe6, 0xe8, 0x9b, 0xed, 0x9c, 0x3e,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x30,
+}
+
+// HideLen returns the length of the uniform representations of points.
+func (P *point) HideLen() int {
+	return 32
+}
+
+// HideEncode returns a uniform representation of P, or nil if P itself has
+// no Elligator representative, which is a property of P only, so that
+// key.GenHiding can pick encodable keys once and for all. Otherwise,
+// HideEncode adds random points of small order to P until the sum has a
+// representative. P is then encodable with a probability proportional to the
+// number of encodable points of its coset, which makes the representative
+// uniform over all the encodable points of the curve.
+func (P *point) HideEncode(rand cipher.Stream) []byte {
+	var rep [32]byte
+	if !hideRepresentative(&rep, &P.ge, 0) {
+		return nil
+	}
+
+	var Ti [8]cachedGroupElement
+	cachedMultiples(&Ti, &torsion8)
+	for {
+		var b [1]byte
+		rand.XORKeyStream(b[:], b[:])
+
+		// Add a random point of small order to P in constant time.
+		var c cachedGroupElement
+		var t completedGroupElement
+		var A extendedGroupElement
+		selectCached(&c, &Ti, int32(b[0]&7))
+		t.Add(&P.ge, &c)
+		t.ToExtended(&A)
+		if hideRepresentative(&rep, &A, b[0]) {
+			return rep[:]
+		}
+	}
+}
+
+// hideRepresentative sets rep to a representative of A and returns true, or
+// returns false if A has none. Bit 3 of b chooses the sign of the
+// representative and bit 7 its unused high bit.
+func hideRepresentative(rep *[32]byte, A *extendedGroupElement, b byte) bool {
+	// Map A to the Montgomery point (u,v), with u = (Z+Y)/(Z-Y) and
+	// v = sqrt(-(A+2))Z(Z+Y)/X(Z-Y).
+	var inv, zPlusY, u, v fieldElement
+	feSub(&inv, &A.Z, &A.Y)
+	feMul(&inv, &inv, &A.X)
+	feInvert(&inv, &inv) // inv <- 1/X(Z-Y)
+	feAdd(&zPlusY, &A.Z, &A.Y)
+	feMul(&u, &zPlusY, &A.X)
+	feMul(&u, &u, &inv)
+	feMul(&v, &zPlusY, &A.Z)
+	feMul(&v, &v, &inv)
+	feMul(&v, &v, &sqrtMinusAPlus2)
+	if feIsNonZero(&A.X) == 0 || feIsNonZero(&v) == 0 {
+		return false
+	}
+
+	// The sign of v tells which of the two candidates of the map led to
+	// (u,v): r^2 = -(u+A)/2u if v is negative and -u/2(u+A) otherwise.
+	var uPlusA, num, den, tmp, r fieldElement
+	feAdd(&uPlusA, &u, &paramA)
+	feNeg(&num, &u)
+	feAdd(&den, &uPlusA, &uPlusA)
+	feNeg(&tmp, &uPlusA)
+	negV := int32(feIsNegative(&v))
+	feCMove(&num, &tmp, negV)
+	feAdd(&tmp, &u, &u)
+	feCMove(&den, &tmp, negV)
+	if feSqrtRatio(&r, &num, &den) == 0 {
+		return false
+	}
+
+	// Randomize the sign of r and the unused high bit of its encoding.
+	feNeg(&tmp, &r)
+	feCMove(&r, &tmp, int32((b>>3)&1))
+	feToBytes(rep, &r)
+	rep[31] |= b & 0x80
+	return true
+}
+
+// HideDecode sets P to the point represented by rep, which is in the
+// prime-order subgroup whatever rep is.
+func (P *point) HideDecode(rep []byte) {
+	var r, one, x1, x2, gx1, gx2, y1, y2, tmp fieldElement
+	feFromBytes(&r, rep) // ignores the high bit
+	feOne(&one)
+
+	// x1 = -A/(1+2r^2), or -A if the denominator is zero.
+	feSquare2(&tmp, &r)
+	feAdd(&tmp, &tmp, &one)
+	feInvert(&tmp, &tmp)
+	feMul(&x1, &paramA, &tmp)
+	feNeg(&x1, &x1)
+	feNeg(&tmp, &paramA)
+	feCMove(&x1, &tmp, 1-feIsNonZero(&x1))
+	// x2 = -x1-A
+	feNeg(&x2, &x1)
+	feSub(&x2, &x2, &paramA)
+
+	montgomeryRHS(&gx1, &x1)
+	montgomeryRHS(&gx2, &x2)
+	square1 := feSqrtRatio(&y1, &gx1, &one)
+	feSqrtRatio(&y2, &gx2, &one)
+
+	// Pick (x1,-y1) if gx1 is a square and (x2,y2) otherwise.
+	var s, t fieldElement
+	feNeg(&y1, &y1)
+	feCopy(&s, &x2)
+	feCopy(&t, &y2)
+	feCMove(&s, &x1, square1)
+	feCMove(&t, &y1, square1)
+
+	// Map (s,t) to the Edwards point (sqrt(-(A+2))s/t, (s-1)/(s+1)),
+	// or to the identity if t(s+1) is zero.
+	var X, Y, Z, sPlusOne fieldElement
+	feAdd(&sPlusOne, &s, &one)
+	feMul(&X, &sqrtMinusAPlus2, &s)
+	feMul(&X, &X, &sPlusOne)
+	feSub(&Y, &s, &one)
+	feMul(&Y, &Y, &t)
+	feMul(&Z, &t, &sPlusOne)
+
+	var Q, id extendedGroupElement
+	feMul(&Q.X, &X, &Z)
+	feMul(&Q.Y, &Y, &Z)
+	feSquare(&Q.Z, &Z)
+	feMul(&Q.T, &X, &Y)
+	id.Zero()
+	isZero := 1 - feIsNonZero(&Z)
+	feCMove(&Q.X, &id.X, isZero)
+	feCMove(&Q.Y, &id.Y, isZero)
+	feCMove(&Q.Z, &id.Z, isZero)
+	feCMove(&Q.T, &id.T, isZero)
+
+	geScalarMult(&P.ge, &hideCofactor, &Q)
+}
+
+// montgomeryRHS sets h = x^3+Ax^2+x.
+func montgomeryRHS(h, x *fieldElement) {
+	var t fieldElement
+	feAdd(&t, x, &paramA)
+	feMul(&t, &t, x)
+	feAdd(&t, &t, &fieldElement{1})
+	feMul(h, &t, x)
+}
@@ -0,0 +1,48 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHiding(t *testing.T) {
+	suite := NewBlakeSHA256Ed25519()
+	rand := random.New()
+	var h kyber.Hiding = suite.Point().(kyber.Hiding)
+	require.Equal(t, 32, h.HideLen())
+
+	encoded := 0
+	for i := 0; i < 100; i++ {
+		P := suite.Point().Pick(rand)
+		rep := P.(kyber.Hiding).HideEncode(rand)
+		if rep == nil {
+			continue
+		}
+		encoded++
+		require.Equal(t, 32, len(rep))
+
+		// Encodable points remain encodable whatever the randomness.
+		for j := 0; j < 10; j++ {
+			require.NotNil(t, P.(kyber.Hiding).HideEncode(rand))
+		}
+
+		Q := suite.Point()
+		Q.(kyber.Hiding).HideDecode(rep)
+		require.True(t, P.Equal(Q), "iteration %d", i)
+	}
+	// About half of the encodings should succeed.
+	require.True(t, encoded > 25, "only %d successful encodings", encoded)
+
+	// Any string decodes to a point of the prime-order subgroup.
+	for i := 0; i < 20; i++ {
+		rep := make([]byte, 32)
+		random.Bytes(rep, rand)
+		P := suite.Point()
+		P.(kyber.Hiding).HideDecode(rep)
+		Q := suite.Point().Mul(primeOrderScalar, P)
+		require.True(t, Q.Equal(nullPoint), "iteration %d", i)
+	}
+}
@@ -27,6 +27,15 @@ import (
 type point struct {
 	ge      extendedGroupElement
 	varTime bool
+	pre     *preComputedPoint
+}
+
+// preComputedPoint holds the table of multiples of the point A computed by
+// Precompute. It is only used while the point it is attached to still equals
+// A, and is shared by all the copies of that point.
+type preComputedPoint struct {
+	A     extendedGroupElement
+	table *[32][8]preComputedGroupElement
 }
 
 func (P *point) String() string {
@@ -77,12 +86,13 @@ func (P *point) Equal(P2 kyber.Point) bool {
 // Set point to be equal to P2.
 func (P *point) Set(P2 kyber.Point) kyber.Point {
 	P.ge = P2.(*point).ge
+	P.pre = P2.(*point).pre
 	return P
 }
 
 // Set point to be equal to P2.
 func (P *point) Clone() kyber.Point {
-	return &point{ge: P.ge}
+	return &point{ge: P.ge, pre: P.pre}
 }
 
 // Set to the neutral element, which is (0,1) for twisted Edwards curves.
@@ -212,6 +222,8 @@ func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
 
 	if A == nil {
 		geScalarMultBase(&P.ge, a)
+	} else if pre := A.(*point).pre; pre != nil && pre.A == A.(*point).ge {
+		geScalarMultTable(&P.ge, a, pre.table)
 	} else {
 		if P.varTime {
 			geScalarMultVartime(&P.ge, a, &A.(*point).ge)
@@ -222,3 +234,54 @@ func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
 
 	return P
 }
+
+// Precompute returns a copy of P carrying a table of its multiples like the
+// one used for the base point, which makes multiplying the copy by a scalar
+// about three times faster, still in constant time. Building the table costs
+// about as much as two multiplications.
+func (P *point) Precompute() kyber.Point {
+	pre := &preComputedPoint{A: P.ge, table: newPreComputedTable(&P.ge)}
+	return &point{ge: P.ge, varTime: P.varTime, pre: pre}
+}
+
+// MultiMul sets P to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, using Straus's method in constant time.
+func (P *point) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("edwards25519: mismatched number of scalars and points")
+	}
+	a := make([]*[32]byte, len(scalars))
+	A := make([]*extendedGroupElement, len(points))
+	for i := range scalars {
+		a[i] = &scalars[i].(*scalar).v
+		if points[i] == nil {
+			A[i] = &baseext
+		} else {
+			A[i] = &points[i].(*point).ge
+		}
+	}
+	geMultiScalarMult(&P.ge, a, A)
+	return P
+}
+
+// Valid reports whether P is in the subgroup of prime order l generated by
+// the base point. Unlike ristretto255 elements, Ed25519 points decoded with
+// UnmarshalBinary may have a component of small order.
+func (P *point) Valid() bool {
+	var Q point
+	Q.Mul(primeOrderScalar, P)
+	return Q.Equal(nullPoint)
+}
+
+// IsSmallOrder reports whether 8P is the identity, that is whether P is one
+// of the 8 points of small order, the identity included.
+func (P *point) IsSmallOrder() bool {
+	var Q point
+	Q.Mul(cofactorScalar, P)
+	return Q.Equal(nullPoint)
+}
+
+// ClearCofactor sets P to 8A, which is in the subgroup of order l.
+func (P *point) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Mul(cofactorScalar, A)
+}
@@ -0,0 +1,473 @@
+package edwards25519
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+)
+
+// Ristretto255 is a prime-order group built on top of the Ed25519 curve,
+// as specified in RFC 9496 (https://ristretto.group). Every ristretto255
+// element is represented internally by one of the four Ed25519 points of
+// a coset of the 4-torsion subgroup. The encoding, decoding and equality
+// functions below are the only parts that differ from the plain Ed25519
+// point: all the group arithmetic is shared with it.
+
+// sqrtADMinusOne is sqrt(a*d - 1), with a = -1.
+var sqrtADMinusOne = fromFE10(fe10{
+	24849947, 33400850, 43495378, 6347714, 46036536, 32887293, 41837720, 18186727, 66238516, 14525638,
+})
+
+// invSqrtAMinusD is 1/sqrt(a - d), with a = -1.
+var invSqrtAMinusD = fromFE10(fe10{
+	6111466, 4156064, 39310137, 12243467, 41204824, 120896, 20826367, 26493656, 6093567, 31568420,
+})
+
+// oneMinusDSQ is 1 - d^2.
+var oneMinusDSQ = fromFE10(fe10{
+	6275446, 16937061, 44170319, 29780721, 11667076, 7397348, 39186143, 1766194, 42675006, 672202,
+})
+
+// dMinusOneSQ is (d - 1)^2.
+var dMinusOneSQ = fromFE10(fe10{
+	15551776, 22456977, 53683765, 23429360, 55212328, 10178283, 40474537, 4729243, 61826754, 23438029,
+})
+
+// feEqual returns 1 if f == g and 0 otherwise, in constant time.
+func feEqual(f, g *fieldElement) int32 {
+	var fb, gb [32]byte
+	feToBytes(&fb, f)
+	feToBytes(&gb, g)
+	return int32(subtle.ConstantTimeCompare(fb[:], gb[:]))
+}
+
+// feAbs sets h to |f|, the non-negative one of f and -f.
+func feAbs(h, f *fieldElement) {
+	var neg fieldElement
+	feNeg(&neg, f)
+	feCopy(h, f)
+	feCMove(h, &neg, int32(feIsNegative(f)))
+}
+
+// feSqrtRatio sets r to the non-negative square root of u/v if it exists,
+// and returns 1. Otherwise it sets r to the non-negative square root of
+// sqrt(-1)*u/v and returns 0.
+func feSqrtRatio(r, u, v *fieldElement) int32 {
+	var v3, v7, check, uNeg, uNegI, rPrime fieldElement
+
+	feSquare(&v3, v)
+	feMul(&v3, &v3, v) // v3 = v^3
+	feSquare(&v7, &v3)
+	feMul(&v7, &v7, v) // v7 = v^7
+
+	feMul(r, u, &v7)
+	fePow22523(r, r) // r = (uv^7)^((q-5)/8)
+	feMul(r, r, &v3)
+	feMul(r, r, u) // r = uv^3(uv^7)^((q-5)/8)
+
+	feSquare(&check, r)
+	feMul(&check, &check, v) // check = vr^2
+
+	feNeg(&uNeg, u)
+	feMul(&uNegI, &uNeg, &sqrtM1)
+
+	correctSign := feEqual(&check, u)
+	flippedSign := feEqual(&check, &uNeg)
+	flippedSignI := feEqual(&check, &uNegI)
+
+	feMul(&rPrime, r, &sqrtM1)
+	feCMove(r, &rPrime, flippedSign|flippedSignI)
+	feAbs(r, r)
+
+	return correctSign | flippedSign
+}
+
+// ristrettoEncode writes the canonical ristretto255 encoding of p to s.
+func ristrettoEncode(s *[32]byte, p *extendedGroupElement) {
+	var u1, u2, tmp, invSqrt, den1, den2, zInv fieldElement
+	var ix, iy, enchanted, x, y, denInv, yNeg fieldElement
+
+	feAdd(&u1, &p.Z, &p.Y)
+	feSub(&tmp, &p.Z, &p.Y)
+	feMul(&u1, &u1, &tmp)  // u1 = (z+y)(z-y)
+	feMul(&u2, &p.X, &p.Y) // u2 = xy
+
+	feSquare(&tmp, &u2)
+	feMul(&tmp, &tmp, &u1)
+	var one fieldElement
+	feOne(&one)
+	feSqrtRatio(&invSqrt, &one, &tmp)
+
+	feMul(&den1, &invSqrt, &u1)
+	feMul(&den2, &invSqrt, &u2)
+	feMul(&zInv, &den1, &den2)
+	feMul(&zInv, &zInv, &p.T)
+
+	feMul(&ix, &p.X, &sqrtM1)
+	feMul(&iy, &p.Y, &sqrtM1)
+	feMul(&enchanted, &den1, &invSqrtAMinusD)
+
+	feMul(&tmp, &p.T, &zInv)
+	rotate := int32(feIsNegative(&tmp))
+
+	feCopy(&x, &p.X)
+	feCopy(&y, &p.Y)
+	feCopy(&denInv, &den2)
+	feCMove(&x, &iy, rotate)
+	feCMove(&y, &ix, rotate)
+	feCMove(&denInv, &enchanted, rotate)
+
+	feMul(&tmp, &x, &zInv)
+	feNeg(&yNeg, &y)
+	feCMove(&y, &yNeg, int32(feIsNegative(&tmp)))
+
+	feSub(&tmp, &p.Z, &y)
+	feMul(&tmp, &tmp, &denInv)
+	feAbs(&tmp, &tmp)
+	feToBytes(s, &tmp)
+}
+
+// ristrettoDecode sets p to the element encoded in s, and reports
+// whether s was a valid canonical ristretto255 encoding.
+func ristrettoDecode(p *extendedGroupElement, s []byte) bool {
+	var f, ss, u1, u2, u2sq, v, tmp, invSqrt, denX, denY, one fieldElement
+	var check [32]byte
+
+	if len(s) != 32 {
+		return false
+	}
+
+	// Reject non-canonical encodings and negative field elements.
+	feFromBytes(&f, s)
+	feToBytes(&check, &f)
+	if subtle.ConstantTimeCompare(check[:], s) != 1 || feIsNegative(&f) == 1 {
+		return false
+	}
+
+	feOne(&one)
+	feSquare(&ss, &f)
+	feSub(&u1, &one, &ss) // u1 = 1 - s^2
+	feAdd(&u2, &one, &ss) // u2 = 1 + s^2
+	feSquare(&u2sq, &u2)
+
+	feSquare(&tmp, &u1)
+	feMul(&tmp, &tmp, &d)
+	feNeg(&v, &tmp)
+	feSub(&v, &v, &u2sq) // v = -(d u1^2) - u2^2
+
+	feMul(&tmp, &v, &u2sq)
+	wasSquare := feSqrtRatio(&invSqrt, &one, &tmp)
+
+	feMul(&denX, &invSqrt, &u2)
+	feMul(&denY, &invSqrt, &denX)
+	feMul(&denY, &denY, &v)
+
+	feAdd(&p.X, &f, &f)
+	feMul(&p.X, &p.X, &denX)
+	feAbs(&p.X, &p.X)
+	feMul(&p.Y, &u1, &denY)
+	feOne(&p.Z)
+	feMul(&p.T, &p.X, &p.Y)
+
+	return wasSquare == 1 && feIsNegative(&p.T) == 0 && feIsNonZero(&p.Y) == 1
+}
+
+// ristrettoMap implements the one-way map from a field element to a
+// ristretto255 element, as used by the hash-to-group construction.
+func ristrettoMap(p *extendedGroupElement, t *fieldElement) {
+	var one, minusOne, r, u, v, tmp, s, sPrime, c, n fieldElement
+	var w0, w1, w2, w3 fieldElement
+
+	feOne(&one)
+	feNeg(&minusOne, &one)
+
+	feSquare(&r, t)
+	feMul(&r, &r, &sqrtM1) // r = sqrt(-1) t^2
+
+	feAdd(&u, &r, &one)
+	feMul(&u, &u, &oneMinusDSQ) // u = (r + 1)(1 - d^2)
+
+	feMul(&tmp, &r, &d)
+	feSub(&v, &minusOne, &tmp)
+	feAdd(&tmp, &r, &d)
+	feMul(&v, &v, &tmp) // v = (-1 - rd)(r + d)
+
+	wasSquare := feSqrtRatio(&s, &u, &v)
+	feMul(&sPrime, &s, t)
+	feAbs(&sPrime, &sPrime)
+	feNeg(&sPrime, &sPrime)
+	feCMove(&s, &sPrime, 1-wasSquare)
+
+	feCopy(&c, &r)
+	feCMove(&c, &minusOne, wasSquare)
+
+	feSub(&tmp, &r, &one)
+	feMul(&n, &c, &tmp)
+	feMul(&n, &n, &dMinusOneSQ)
+	feSub(&n, &n, &v) // n = c(r - 1)(d - 1)^2 - v
+
+	feAdd(&w0, &s, &s)
+	feMul(&w0, &w0, &v)
+	feMul(&w1, &n, &sqrtADMinusOne)
+	feSquare(&tmp, &s)
+	feSub(&w2, &one, &tmp)
+	feAdd(&w3, &one, &tmp)
+
+	feMul(&p.X, &w0, &w3)
+	feMul(&p.Y, &w2, &w1)
+	feMul(&p.Z, &w1, &w3)
+	feMul(&p.T, &w0, &w2)
+}
+
+// ristrettoFromUniformBytes sets p to the ristretto255 element derived
+// from 64 uniformly random bytes b, using the map of RFC 9496 section 4.3.4.
+func ristrettoFromUniformBytes(p *extendedGroupElement, b []byte) {
+	var buf [32]byte
+	var t fieldElement
+	var p1, p2 extendedGroupElement
+	var c cachedGroupElement
+	var r completedGroupElement
+
+	copy(buf[:], b[:32])
+	buf[31] &= 0x7f
+	feFromBytes(&t, buf[:])
+	ristrettoMap(&p1, &t)
+
+	copy(buf[:], b[32:64])
+	buf[31] &= 0x7f
+	feFromBytes(&t, buf[:])
+	ristrettoMap(&p2, &t)
+
+	p2.ToCached(&c)
+	r.Add(&p1, &c)
+	r.ToExtended(p)
+}
+
+// ristrettoPoint is a ristretto255 group element.
+type ristrettoPoint struct {
+	ge extendedGroupElement
+}
+
+// NewRistrettoPoint returns a new ristretto255 group element,
+// initialized to the identity. Its scalars are the ones of the
+// Ed25519 prime-order subgroup returned by Curve.Scalar.
+// Most users want package github.com/dedis/kyber/group/ristretto255,
+// which provides the corresponding kyber.Group and suite.
+func NewRistrettoPoint() kyber.Point {
+	P := new(ristrettoPoint)
+	P.ge.Zero()
+	return P
+}
+
+func (P *ristrettoPoint) String() string {
+	var b [32]byte
+	ristrettoEncode(&b, &P.ge)
+	return hex.EncodeToString(b[:])
+}
+
+func (P *ristrettoPoint) MarshalSize() int {
+	return 32
+}
+
+func (P *ristrettoPoint) MarshalBinary() ([]byte, error) {
+	var b [32]byte
+	ristrettoEncode(&b, &P.ge)
+	return b[:], nil
+}
+
+func (P *ristrettoPoint) UnmarshalBinary(b []byte) error {
+	var ge extendedGroupElement
+	if !ristrettoDecode(&ge, b) {
+		return errors.New("invalid ristretto255 point")
+	}
+	P.ge = ge
+	return nil
+}
+
+func (P *ristrettoPoint) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+func (P *ristrettoPoint) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
+
+// Equal tests two ristretto255 elements for equality, that is whether the
+// underlying Ed25519 points belong to the same coset of the 4-torsion.
+func (P *ristrettoPoint) Equal(P2 kyber.Point) bool {
+	Q := &P2.(*ristrettoPoint).ge
+	var a, b fieldElement
+
+	feMul(&a, &P.ge.X, &Q.Y)
+	feMul(&b, &P.ge.Y, &Q.X)
+	eq1 := feEqual(&a, &b)
+
+	feMul(&a, &P.ge.Y, &Q.Y)
+	feMul(&b, &P.ge.X, &Q.X)
+	eq2 := feEqual(&a, &b)
+
+	return eq1|eq2 == 1
+}
+
+func (P *ristrettoPoint) Set(P2 kyber.Point) kyber.Point {
+	P.ge = P2.(*ristrettoPoint).ge
+	return P
+}
+
+func (P *ristrettoPoint) Clone() kyber.Point {
+	return &ristrettoPoint{ge: P.ge}
+}
+
+// Null sets P to the identity element.
+func (P *ristrettoPoint) Null() kyber.Point {
+	P.ge.Zero()
+	return P
+}
+
+// Base sets P to the standard ristretto255 generator,
+// which is the class of the Ed25519 base point.
+func (P *ristrettoPoint) Base() kyber.Point {
+	P.ge = baseext
+	return P
+}
+
+func (P *ristrettoPoint) EmbedLen() int {
+	// Reserve the most-significant 8 bits for pseudo-randomness.
+	// Reserve the least-significant 8 bits for embedded data length,
+	// whose lowest bit must be zero for the encoding to be valid.
+	return (255 - 8 - 8) / 8
+}
+
+func (P *ristrettoPoint) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	// Without data to embed, use the uniform map,
+	// which always succeeds at the first attempt.
+	if data == nil {
+		var b [64]byte
+		rand.XORKeyStream(b[:], b[:])
+		ristrettoFromUniformBytes(&P.ge, b[:])
+		return P
+	}
+
+	// How many bytes to embed?
+	dl := P.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		// Every valid encoding is a distinct element of the prime-order
+		// group, so we simply need a random encoding that decodes.
+		var b [32]byte
+		rand.XORKeyStream(b[:], b[:])
+		b[0] = byte(dl) << 1  // Encode length, keeping the value non-negative
+		copy(b[1:1+dl], data) // Copy in data to embed
+		b[31] &= 0x7f
+		if ristrettoDecode(&P.ge, b[:]) {
+			return P // success
+		}
+	}
+}
+
+func (P *ristrettoPoint) Pick(rand cipher.Stream) kyber.Point {
+	return P.Embed(nil, rand)
+}
+
+// Extract embedded data from a point group element
+func (P *ristrettoPoint) Data() ([]byte, error) {
+	var b [32]byte
+	ristrettoEncode(&b, &P.ge)
+	dl := int(b[0] >> 1) // extract length byte
+	if dl > P.EmbedLen() {
+		return nil, errors.New("invalid embedded data length")
+	}
+	return b[1 : 1+dl], nil
+}
+
+func (P *ristrettoPoint) Add(P1, P2 kyber.Point) kyber.Point {
+	E1 := P1.(*ristrettoPoint)
+	E2 := P2.(*ristrettoPoint)
+
+	var t2 cachedGroupElement
+	var r completedGroupElement
+
+	E2.ge.ToCached(&t2)
+	r.Add(&E1.ge, &t2)
+	r.ToExtended(&P.ge)
+
+	return P
+}
+
+func (P *ristrettoPoint) Sub(P1, P2 kyber.Point) kyber.Point {
+	E1 := P1.(*ristrettoPoint)
+	E2 := P2.(*ristrettoPoint)
+
+	var t2 cachedGroupElement
+	var r completedGroupElement
+
+	E2.ge.ToCached(&t2)
+	r.Sub(&E1.ge, &t2)
+	r.ToExtended(&P.ge)
+
+	return P
+}
+
+func (P *ristrettoPoint) Neg(A kyber.Point) kyber.Point {
+	P.ge.Neg(&A.(*ristrettoPoint).ge)
+	return P
+}
+
+// Mul multiplies point A by scalar s, or the base point if A is nil,
+// in constant time.
+func (P *ristrettoPoint) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
+	a := &s.(*scalar).v
+
+	if A == nil {
+		geScalarMultBase(&P.ge, a)
+	} else {
+		geScalarMult(&P.ge, a, &A.(*ristrettoPoint).ge)
+	}
+
+	return P
+}
+
+// Valid returns true, since every ristretto255 element is a multiple of the
+// generator.
+func (P *ristrettoPoint) Valid() bool {
+	return true
+}
+
+// IsSmallOrder reports whether P is the identity element, the only element
+// of small order of ristretto255.
+func (P *ristrettoPoint) IsSmallOrder() bool {
+	return P.Equal(NewRistrettoPoint())
+}
+
+// ClearCofactor sets P to A, since ristretto255 has prime order.
+func (P *ristrettoPoint) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Set(A)
+}
+
+// MultiMul sets P to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, in constant time.
+func (P *ristrettoPoint) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("ristretto255: mismatched number of scalars and points")
+	}
+	a := make([]*[32]byte, len(scalars))
+	A := make([]*extendedGroupElement, len(points))
+	for i := range scalars {
+		a[i] = &scalars[i].(*scalar).v
+		if points[i] == nil {
+			A[i] = &baseext
+		} else {
+			A[i] = &points[i].(*ristrettoPoint).ge
+		}
+	}
+	geMultiScalarMult(&P.ge, a, A)
+	return P
+}
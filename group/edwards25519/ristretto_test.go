@@ -0,0 +1,108 @@
+package edwards25519
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from RFC 9496, appendix A.
+
+func TestRistrettoBasepointMultiples(t *testing.T) {
+	multiples := []string{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+		"6a493210f7499cd17fecb510ae0cea23a110e8d5b901f8acadd3095c73a3b919",
+		"94741f5d5d52755ece4f23f044ee27d5d1ea1e2bd196b462166b16152a9d0259",
+		"da80862773358b466ffadfe0b3293ab3d9fd53c5ea6c955358f568322daf6a57",
+		"e882b131016b52c1d3337080187cf768423efccbb517bb495ab812c4160ff44e",
+		"f64746d3c92b13050ed8d80236a7f0007c3b3f962f5ba793d19a601ebb1df403",
+		"44f53520926ec81fbd5a387845beb7df85a96a24ece18738bdcfa6a7822a176d",
+		"903293d8f2287ebe10e2374dc1a53e0bc887e592699f02d077d5263cdd55601c",
+	}
+
+	B := NewRistrettoPoint().Base()
+	P := NewRistrettoPoint()
+	for i, m := range multiples {
+		b, err := P.MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, m, hex.EncodeToString(b), "multiple %d", i)
+
+		Q := NewRistrettoPoint()
+		buf, _ := hex.DecodeString(m)
+		require.Nil(t, Q.UnmarshalBinary(buf))
+		require.True(t, Q.Equal(P), "multiple %d", i)
+
+		P.Add(P, B)
+	}
+}
+
+func TestRistrettoBadEncodings(t *testing.T) {
+	bad := []string{
+		// Non-canonical field encodings.
+		"00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"f3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// Negative field elements.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"01ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"ed57ffd8c914fb201471d1c3d245ce3c746fcbe63a3679d51b6a516ebebe0e20",
+		// Non-square x^2.
+		"26948d35ca62e643e26a83177332e6b6afeb9d08e4268b650f1f5bbd8d81d371",
+		"4eac077a713c57b4f4397629a4145982c661f48044dd3f96427d40b147d9742f",
+		// Negative xy value.
+		"3eb858e78f5a7254d8c9731174a94f76755fd3941c0ac93735c07ba14579630e",
+		// s = -1, which causes y = 0.
+		"ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+	}
+
+	P := NewRistrettoPoint()
+	for _, s := range bad {
+		b, _ := hex.DecodeString(s)
+		require.NotNil(t, P.UnmarshalBinary(b), s)
+	}
+}
+
+func TestRistrettoFromUniformBytes(t *testing.T) {
+	vectors := []struct {
+		label, point string
+	}{
+		{"Ristretto is traditionally a short shot of espresso coffee",
+			"3066f82a1a747d45120d1740f14358531a8f04bbffe6a819f86dfe50f44a0a46"},
+		{"made with the normal amount of ground coffee but extracted with",
+			"f26e5b6f7d362d2d2a94c5d0e7602cb4773c95a2e5c31a64f133189fa76ed61b"},
+		{"about half the amount of water in the same amount of time",
+			"006ccd2a9e6867e6a2c5cea83d3302cc9de128dd2a9a57dd8ee7b9d7ffe02826"},
+	}
+
+	for _, v := range vectors {
+		h := sha512.Sum512([]byte(v.label))
+		P := new(ristrettoPoint)
+		ristrettoFromUniformBytes(&P.ge, h[:])
+		require.Equal(t, v.point, P.String(), v.label)
+	}
+}
+
+func TestRistrettoEqual(t *testing.T) {
+	// Adding a 4-torsion point does not change the ristretto255 element.
+	var torsion, sum extendedGroupElement
+	torsion.Zero()
+	feCopy(&torsion.X, &sqrtM1)
+	feZero(&torsion.Y)
+	feZero(&torsion.T)
+
+	P := NewRistrettoPoint().Pick(tSuite.RandomStream()).(*ristrettoPoint)
+	var c cachedGroupElement
+	var r completedGroupElement
+	torsion.ToCached(&c)
+	r.Add(&P.ge, &c)
+	r.ToExtended(&sum)
+
+	Q := &ristrettoPoint{ge: sum}
+	require.True(t, P.Equal(Q))
+	require.Equal(t, P.String(), Q.String())
+	require.False(t, P.Equal(NewRistrettoPoint()))
+}
@@ -141,6 +141,49 @@ func (s *scalar) SetBytes(b []byte) kyber.Scalar {
 	return s.setInt(mod.NewIntBytes(b, primeOrder, mod.LittleEndian))
 }
 
+// SetBytesWide sets s to b, interpreted as a little endian integer of any
+// length and reduced modulo the prime order, such as a 64-byte hash.
+func (s *scalar) SetBytesWide(b []byte) kyber.Scalar {
+	return s.SetBytes(b)
+}
+
+// Exp sets s to a^e. The running time depends on the exponent e, which is
+// assumed to be public, but not on a. Negative exponents raise 1/a.
+func (s *scalar) Exp(a kyber.Scalar, e *big.Int) kyber.Scalar {
+	var base, res scalar
+	base.Set(a)
+	if e.Sign() < 0 {
+		base.Inv(&base)
+		e = new(big.Int).Neg(e)
+	}
+	res.One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		scMul(&res.v, &res.v, &res.v)
+		if e.Bit(i) == 1 {
+			scMul(&res.v, &res.v, &base.v)
+		}
+	}
+	s.v = res.v
+	return s
+}
+
+// Sqrt sets s to a square root of a and returns true if a is a square, or
+// returns false and leaves s unchanged otherwise. It runs in variable time.
+func (s *scalar) Sqrt(a kyber.Scalar) bool {
+	r := mod.NewInt64(0, primeOrder)
+	if !r.Sqrt(a.(*scalar).toInt()) {
+		return false
+	}
+	s.setInt(r)
+	return true
+}
+
+// Legendre returns the Legendre symbol of s modulo the prime order. It runs
+// in variable time.
+func (s *scalar) Legendre() int {
+	return s.toInt().Legendre()
+}
+
 // String returns the string representation of this scalar (fixed length of 32 bytes, little endian).
 func (s *scalar) String() string {
 	b, _ := s.toInt().MarshalBinary()
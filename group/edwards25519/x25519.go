@@ -0,0 +1,197 @@
+package edwards25519
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+// X25519Basepoint is the u-coordinate of the base point of X25519, which is
+// the image of the Ed25519 base point on the Montgomery curve.
+var X25519Basepoint = []byte{9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// a24 is (A-2)/4 = 121665, the constant of the Montgomery ladder for the
+// curve v^2 = u^3 + A*u^2 + u with A = 486662.
+var a24 = fromFE10(fe10{121665})
+
+// X25519 returns the result of the X25519 function of RFC 7748 applied to
+// the 32-byte secret scalar and the 32-byte u-coordinate point: scalar is
+// clamped, and the top bit of point is ignored. Use X25519Basepoint as point
+// to compute the public key of scalar, and the public key of a peer to
+// compute a shared secret. X25519 runs in constant time.
+//
+// X25519 returns an error if the inputs are not 32 bytes long, or if the
+// result is all zeros, which happens when point has a small order, so that
+// the shared secret does not depend on scalar, as RFC 7748 recommends.
+func X25519(scalar, point []byte) ([]byte, error) {
+	if len(scalar) != 32 || len(point) != 32 {
+		return nil, errors.New("edwards25519: X25519 inputs must be 32 bytes long")
+	}
+	var k, out [32]byte
+	copy(k[:], scalar)
+	k[0] &= 248
+	k[31] &= 127
+	k[31] |= 64
+	x25519Ladder(&out, &k, point)
+
+	var zero [32]byte
+	if subtle.ConstantTimeCompare(out[:], zero[:]) == 1 {
+		return nil, errors.New("edwards25519: X25519 output is all zeros")
+	}
+	return out[:], nil
+}
+
+// x25519Ladder sets out to the u-coordinate of k times the point of
+// u-coordinate u, with the Montgomery ladder of RFC 7748, section 5.
+func x25519Ladder(out, k *[32]byte, u []byte) {
+	var x1, x2, z2, x3, z3, t fieldElement
+	var A, AA, B, BB, E, C, D, DA, CB fieldElement
+	feFromBytes(&x1, u)
+	feOne(&x2)
+	feZero(&z2)
+	feCopy(&x3, &x1)
+	feOne(&z3)
+
+	swap := int32(0)
+	for pos := 254; pos >= 0; pos-- {
+		b := int32(k[pos/8]>>uint(pos&7)) & 1
+		swap ^= b
+		feCSwap(&x2, &x3, &t, swap)
+		feCSwap(&z2, &z3, &t, swap)
+		swap = b
+
+		feAdd(&A, &x2, &z2)
+		feSquare(&AA, &A)
+		feSub(&B, &x2, &z2)
+		feSquare(&BB, &B)
+		feSub(&E, &AA, &BB)
+		feAdd(&C, &x3, &z3)
+		feSub(&D, &x3, &z3)
+		feMul(&DA, &D, &A)
+		feMul(&CB, &C, &B)
+
+		feAdd(&x3, &DA, &CB)
+		feSquare(&x3, &x3)
+		feSub(&z3, &DA, &CB)
+		feSquare(&z3, &z3)
+		feMul(&z3, &z3, &x1)
+		feMul(&x2, &AA, &BB)
+		feMul(&z2, &a24, &E)
+		feAdd(&z2, &z2, &AA)
+		feMul(&z2, &z2, &E)
+	}
+	feCSwap(&x2, &x3, &t, swap)
+	feCSwap(&z2, &z3, &t, swap)
+
+	feInvert(&z2, &z2)
+	feMul(&x2, &x2, &z2)
+	feToBytes(out, &x2)
+}
+
+// feCSwap swaps f and g if b == 1, and leaves them unchanged if b == 0,
+// using t as a temporary.
+func feCSwap(f, g, t *fieldElement, b int32) {
+	feCopy(t, f)
+	feCMove(f, g, b)
+	feCMove(g, t, b)
+}
+
+// lBytes is the order l of the base point in little-endian form.
+var lBytes = [32]byte{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0x10,
+}
+
+// clampedScalar sets c to the unique integer of [0, 8l) that is s modulo l
+// and 0 modulo 8, and returns 1 if c is a clamped X25519 scalar, that is
+// if 2^254 <= c < 2^255, and 0 otherwise, in constant time.
+func clampedScalar(c *[32]byte, s *[32]byte) int {
+	// c = s + t*l, with t = -s/l = -5s mod 8 since l = 5 mod 8.
+	t := uint32(-5*int32(s[0])) & 7
+	var carry uint32
+	for i := range c {
+		v := uint32(s[i]) + t*uint32(lBytes[i]) + carry
+		c[i] = byte(v)
+		carry = v >> 8
+	}
+	return int(c[31]>>6) & 1 & int(^c[31]>>7)
+}
+
+// ScalarToX25519 returns the X25519 secret key with which X25519 computes
+// the same Diffie-Hellman results as the Ed25519 secret key s does, such as
+// the shared secret with the peer of X25519 public key PointToX25519(P),
+// which is X25519(ScalarToX25519(s), PointToX25519(P)) and also
+// PointToX25519(s*P).
+//
+// Since X25519 clamps its secret keys, the result is an equivalent of s or
+// -s, which give the same u-coordinates, with the form that clamping does
+// not modify. Random scalars, such as the keys generated by Curve.NewKey,
+// only lack such an equivalent with a negligible probability, in which case
+// ScalarToX25519 returns an error.
+func ScalarToX25519(s kyber.Scalar) ([]byte, error) {
+	var neg scalar
+	neg.Neg(s)
+	var c, cNeg [32]byte
+	ok := clampedScalar(&c, &s.(*scalar).v)
+	okNeg := clampedScalar(&cNeg, &neg.v)
+	subtle.ConstantTimeCopy(1-ok, c[:], cNeg[:])
+	if ok|okNeg == 0 {
+		return nil, errors.New("edwards25519: scalar has no X25519 equivalent")
+	}
+	return c[:], nil
+}
+
+// PointToX25519 returns the X25519 public key that corresponds to the
+// Ed25519 point P: the u-coordinate (1+y)/(1-y) of its image on the
+// Montgomery curve, as PublicKeyToCurve25519 computes it.
+func PointToX25519(P kyber.Point) ([]byte, error) {
+	var b, u [32]byte
+	enc, err := P.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	copy(b[:], enc)
+	if !PublicKeyToCurve25519(&u, &b) {
+		return nil, errors.New("edwards25519: invalid point")
+	}
+	return u[:], nil
+}
+
+// X25519ToPoint returns the Ed25519 point whose image on the Montgomery
+// curve has the u-coordinate u, which is an X25519 public key, with the
+// y-coordinate (u-1)/(u+1). The sign of the x-coordinate is lost in X25519
+// keys, so X25519ToPoint returns the point P with an even x-coordinate, where
+// the owner of the key may have -P instead. Both give the same results in
+// Diffie-Hellman exchanges, but the point must not be used to verify
+// signatures.
+//
+// X25519ToPoint returns an error if u is not 32 bytes long or is not the
+// u-coordinate of a point of the curve, such as the points of the twist
+// that X25519 accepts.
+func X25519ToPoint(u []byte) (kyber.Point, error) {
+	if len(u) != 32 {
+		return nil, errors.New("edwards25519: X25519 public keys must be 32 bytes long")
+	}
+	var x, num, den fieldElement
+	var y [32]byte
+	feFromBytes(&x, u)
+	feOne(&den)
+	feSub(&num, &x, &den)
+	feAdd(&den, &x, &den)
+	if feIsNonZero(&den) == 0 {
+		return nil, errors.New("edwards25519: invalid X25519 public key")
+	}
+	feInvert(&den, &den)
+	feMul(&num, &num, &den)
+	feToBytes(&y, &num)
+
+	P := new(point)
+	if err := P.UnmarshalBinary(y[:]); err != nil {
+		return nil, errors.New("edwards25519: invalid X25519 public key")
+	}
+	return P, nil
+}
@@ -0,0 +1,147 @@
+package edwards25519
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+)
+
+func fromHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// Test vectors from RFC 7748, section 5.2.
+func TestX25519Vectors(t *testing.T) {
+	vectors := []struct{ scalar, point, out string }{
+		{
+			"a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+			"e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+			"c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+		},
+		{
+			"4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+			"e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+			"95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+		},
+	}
+	for _, v := range vectors {
+		out, err := X25519(fromHex(t, v.scalar), fromHex(t, v.point))
+		require.NoError(t, err)
+		require.Equal(t, v.out, hex.EncodeToString(out))
+	}
+
+	k, u := X25519Basepoint, X25519Basepoint
+	for i := 1; i <= 1000; i++ {
+		out, err := X25519(k, u)
+		require.NoError(t, err)
+		k, u = out, k
+		switch i {
+		case 1:
+			require.Equal(t, "422c8e7a6227d7bca1350b3e2bb7279f7897b87bb6854b783c60e80311ae3079", hex.EncodeToString(k))
+		case 1000:
+			require.Equal(t, "684cf59ba83309552800ef566f2f4d3c1c3887c49360e3875f2eb94d99532c51", hex.EncodeToString(k))
+		}
+	}
+}
+
+// Diffie-Hellman test vectors from RFC 7748, section 6.1.
+func TestX25519DiffieHellman(t *testing.T) {
+	alice := fromHex(t, "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bob := fromHex(t, "5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb")
+
+	alicePub, err := X25519(alice, X25519Basepoint)
+	require.NoError(t, err)
+	require.Equal(t, "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a", hex.EncodeToString(alicePub))
+	bobPub, err := X25519(bob, X25519Basepoint)
+	require.NoError(t, err)
+	require.Equal(t, "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f", hex.EncodeToString(bobPub))
+
+	k1, err := X25519(alice, bobPub)
+	require.NoError(t, err)
+	k2, err := X25519(bob, alicePub)
+	require.NoError(t, err)
+	require.Equal(t, "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742", hex.EncodeToString(k1))
+	require.Equal(t, k1, k2)
+}
+
+func TestX25519Errors(t *testing.T) {
+	k := make([]byte, 32)
+	_, err := X25519(k[:31], X25519Basepoint)
+	require.Error(t, err)
+	_, err = X25519(k, X25519Basepoint[:31])
+	require.Error(t, err)
+
+	// The point of order 1 and u = 0 give an all-zero output.
+	k[0] = 1
+	_, err = X25519(k, make([]byte, 32))
+	require.Error(t, err)
+}
+
+func TestX25519Compare(t *testing.T) {
+	rand := random.New()
+	for i := 0; i < 50; i++ {
+		k, u := make([]byte, 32), make([]byte, 32)
+		random.Bytes(k, rand)
+		random.Bytes(u, rand)
+		out, err := X25519(k, u)
+		require.NoError(t, err)
+		exp, err := curve25519.X25519(k, u)
+		require.NoError(t, err)
+		require.Equal(t, exp, out)
+	}
+}
+
+func TestX25519Conversions(t *testing.T) {
+	suite := NewBlakeSHA256Ed25519()
+	rand := random.New()
+
+	base, err := PointToX25519(suite.Point().Base())
+	require.NoError(t, err)
+	require.Equal(t, X25519Basepoint, base)
+
+	for i := 0; i < 20; i++ {
+		s := suite.NewKey(rand)
+		Q := suite.Point().Pick(rand)
+		k, err := ScalarToX25519(s)
+		require.NoError(t, err)
+		u, err := PointToX25519(Q)
+		require.NoError(t, err)
+
+		// Both sides of the exchange agree.
+		shared, err := X25519(k, u)
+		require.NoError(t, err)
+		exp, err := PointToX25519(suite.Point().Mul(s, Q))
+		require.NoError(t, err)
+		require.Equal(t, exp, shared)
+
+		// X25519ToPoint returns Q or -Q.
+		P, err := X25519ToPoint(u)
+		require.NoError(t, err)
+		require.True(t, P.Equal(Q) || P.Equal(suite.Point().Neg(Q)))
+	}
+
+	// The keys of PrivateKeyToCurve25519 and PublicKeyToCurve25519 match.
+	var priv [64]byte
+	var k, pub [32]byte
+	random.Bytes(priv[:], rand)
+	PrivateKeyToCurve25519(&k, &priv)
+	s := suite.Scalar().SetBytes(k[:])
+	enc, err := suite.Point().Mul(s, nil).MarshalBinary()
+	require.NoError(t, err)
+	copy(pub[:], enc)
+	require.True(t, PublicKeyToCurve25519(&pub, &pub))
+	exp, err := X25519(k[:], X25519Basepoint)
+	require.NoError(t, err)
+	require.Equal(t, exp, pub[:])
+
+	_, err = X25519ToPoint(make([]byte, 31))
+	require.Error(t, err)
+	uMinusOne := fromHex(t, "ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	_, err = X25519ToPoint(uMinusOne)
+	require.Error(t, err)
+}
@@ -0,0 +1,443 @@
+// Package montgomery implements constant-time arithmetic modulo odd
+// integers, as needed for the coordinates and scalars of elliptic curves
+// and for the MontInts of package mod.
+//
+// Elements are kept in Montgomery form as n 64-bit little-endian limbs,
+// where n is the number of limbs of the modulus, always reduced, and all
+// operations run in constant time with respect to their operands. Only
+// exponents and lengths are allowed to influence the running time, as they
+// are public, such as m-2.
+//
+// The fields of up to 512 bits can use the fixed-size Element type. The
+// methods whose names end in Limbs take the elements of any field as slices
+// of exactly n limbs instead.
+package montgomery
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// MaxLimbs is the number of limbs of an Element, which is enough for the
+// fields of up to 512 bits.
+const MaxLimbs = 8
+
+// Element is an element of a field of up to MaxLimbs limbs in Montgomery
+// form, whose limbs beyond those of the modulus are zero. The zero value is
+// the zero of every field.
+type Element [MaxLimbs]uint64
+
+// Field holds the constants of Montgomery arithmetic modulo an odd integer m
+// of n limbs, with R = 2^(64n).
+type Field struct {
+	n      int      // the number of limbs
+	m      []uint64 // the modulus
+	mInv   uint64   // -m^-1 mod 2^64
+	rr     []uint64 // R^2 mod m, to convert to Montgomery form
+	one    []uint64 // R mod m, which is 1 in Montgomery form
+	big    *big.Int // the modulus as a big.Int
+	byteLn int      // the length of the modulus in bytes
+}
+
+// NewField returns the integers modulo m, which must be odd and greater
+// than 1, and prime for Inv.
+func NewField(m *big.Int) *Field {
+	if m.Sign() <= 0 || m.Bit(0) == 0 || m.BitLen() == 1 {
+		panic("montgomery: modulus must be odd and greater than 1")
+	}
+	n := (m.BitLen() + 63) / 64
+	f := &Field{n: n, big: new(big.Int).Set(m), byteLn: (m.BitLen() + 7) / 8}
+	f.m = f.limbs(m)
+
+	// Newton's iteration doubles the number of correct low bits of the
+	// inverse of the odd m[0] at each step.
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - f.m[0]*inv
+	}
+	f.mInv = -inv
+
+	r := new(big.Int).Lsh(big.NewInt(1), uint(64*n))
+	f.one = f.limbs(new(big.Int).Mod(r, m))
+	f.rr = f.limbs(r.Mod(r.Mul(r, r), m))
+	return f
+}
+
+// Modulus returns the modulus of the field, which must not be modified.
+func (f *Field) Modulus() *big.Int {
+	return f.big
+}
+
+// Limbs returns the number n of limbs of the elements.
+func (f *Field) Limbs() int {
+	return f.n
+}
+
+// ByteLen returns the length in bytes of the encodings of Bytes.
+func (f *Field) ByteLen() int {
+	return f.byteLn
+}
+
+// One returns the Montgomery form of 1.
+func (f *Field) One() Element {
+	var z Element
+	copy(z[:f.n], f.one)
+	return z
+}
+
+// limbs returns the n little-endian limbs of 0 <= x < 2^(64n).
+func (f *Field) limbs(x *big.Int) []uint64 {
+	z := make([]uint64, f.n)
+	limbsFromBytes(z, x.FillBytes(make([]byte, 8*f.n)))
+	return z
+}
+
+// limbsFromBytes sets z to the big-endian integer b, which must be exactly
+// 8*len(z) bytes long.
+func limbsFromBytes(z []uint64, b []byte) {
+	for i := range z {
+		var w uint64
+		for _, c := range b[len(b)-8*i-8 : len(b)-8*i] {
+			w = w<<8 | uint64(c)
+		}
+		z[i] = w
+	}
+}
+
+// limbsToBytes encodes x as a big-endian integer in b, which must be
+// exactly 8*len(x) bytes long.
+func limbsToBytes(b []byte, x []uint64) {
+	for i, w := range x {
+		for j := 0; j < 8; j++ {
+			b[len(b)-8*i-1-j] = byte(w >> uint(8*j))
+		}
+	}
+}
+
+// Add sets z = x+y.
+func (f *Field) Add(z, x, y *Element) {
+	f.AddLimbs(z[:f.n], x[:f.n], y[:f.n])
+}
+
+// Sub sets z = x-y.
+func (f *Field) Sub(z, x, y *Element) {
+	f.SubLimbs(z[:f.n], x[:f.n], y[:f.n])
+}
+
+// Neg sets z = -x.
+func (f *Field) Neg(z, x *Element) {
+	f.Sub(z, &Element{}, x)
+}
+
+// Mul sets z = xy/R, which is the Montgomery form of the product of x and y
+// when they are in Montgomery form.
+func (f *Field) Mul(z, x, y *Element) {
+	f.MulLimbs(z[:f.n], x[:f.n], y[:f.n])
+}
+
+// Square sets z = x².
+func (f *Field) Square(z, x *Element) {
+	f.Mul(z, x, x)
+}
+
+// Exp sets z = x^e, in time that only depends on the length of the public
+// exponent e, which must not be negative.
+func (f *Field) Exp(z, x *Element, e *big.Int) {
+	f.ExpLimbs(z[:f.n], x[:f.n], e.Bytes())
+}
+
+// Inv sets z = 1/x, or 0 if x is 0, by Fermat's little theorem.
+func (f *Field) Inv(z, x *Element) {
+	f.Exp(z, x, new(big.Int).Sub(f.big, big.NewInt(2)))
+}
+
+// SetBytes sets z to the Montgomery form of the big-endian integer b of
+// ByteLen bytes, and returns false if it is not reduced modulo m, in which
+// case z is left unchanged.
+func (f *Field) SetBytes(z *Element, b []byte) bool {
+	return f.SetBytesLimbs(z[:f.n], b)
+}
+
+// SetBytesWide sets z to the Montgomery form of the big-endian integer b of
+// any length reduced modulo m, in time that only depends on the length of b.
+func (f *Field) SetBytesWide(z *Element, b []byte) {
+	f.SetBytesWideLimbs(z[:f.n], b)
+}
+
+// SetBig sets z to the Montgomery form of n mod m.
+func (f *Field) SetBig(z *Element, n *big.Int) {
+	*z = Element{}
+	f.SetBigLimbs(z[:f.n], n)
+}
+
+// Bytes returns the big-endian encoding of the integer x represents, of
+// ByteLen bytes.
+func (f *Field) Bytes(x *Element) []byte {
+	return f.BytesLimbs(x[:f.n])
+}
+
+// IsOdd returns 1 if the integer x represents is odd, and 0 otherwise.
+func (f *Field) IsOdd(x *Element) uint64 {
+	return f.IsOddLimbs(x[:f.n])
+}
+
+// CMove sets z = x if c is 1, and leaves z unchanged if c is 0.
+func CMove(z, x *Element, c uint64) {
+	SelectLimbs(z[:], x[:], z[:], c)
+}
+
+// Equal returns 1 if x = y, and 0 otherwise.
+func Equal(x, y *Element) uint64 {
+	return EqualLimbs(x[:], y[:])
+}
+
+// IsZero returns 1 if x = 0, and 0 otherwise.
+func IsZero(x *Element) uint64 {
+	return Equal(x, &Element{})
+}
+
+// IsZeroWord returns 1 if w = 0, and 0 otherwise.
+func IsZeroWord(w uint64) uint64 {
+	return 1 ^ ((w | -w) >> 63)
+}
+
+// SetOneLimbs sets z to the Montgomery form of 1.
+func (f *Field) SetOneLimbs(z []uint64) {
+	copy(z, f.one)
+}
+
+// AddLimbs sets z = x+y.
+func (f *Field) AddLimbs(z, x, y []uint64) {
+	var c uint64
+	for i := range z {
+		z[i], c = bits.Add64(x[i], y[i], c)
+	}
+	f.reduce(z, z, c)
+}
+
+// SubLimbs sets z = x-y.
+func (f *Field) SubLimbs(z, x, y []uint64) {
+	var b uint64
+	for i := range z {
+		z[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	// Add m back if the subtraction borrowed.
+	mask := -b
+	var c uint64
+	for i := range z {
+		z[i], c = bits.Add64(z[i], f.m[i]&mask, c)
+	}
+}
+
+// MulLimbs sets z = xy/R with the CIOS method. Its inputs must be smaller
+// than m, or one of them smaller than m and the other one smaller than R.
+// z can alias x or y.
+func (f *Field) MulLimbs(z, x, y []uint64) {
+	n := f.n
+	var buf [scratchLimbs]uint64
+	t := scratch(&buf, n+2)
+
+	// Coarsely integrated operand scanning: interleave the multiplication
+	// by each limb of y with the division of the accumulator by 2^64.
+	for i := 0; i < n; i++ {
+		var c, cc uint64
+		for j := 0; j < n; j++ {
+			c, t[j] = madd(x[j], y[i], t[j], c)
+		}
+		t[n], cc = bits.Add64(t[n], c, 0)
+		t[n+1] = cc
+
+		// Add a multiple of m that clears the lowest limb, and shift.
+		k := t[0] * f.mInv
+		c, _ = madd(k, f.m[0], t[0], 0)
+		for j := 1; j < n; j++ {
+			c, t[j-1] = madd(k, f.m[j], t[j], c)
+		}
+		t[n-1], cc = bits.Add64(t[n], c, 0)
+		t[n] = t[n+1] + cc
+	}
+	f.reduce(z, t[:n], t[n])
+}
+
+// ExpLimbs sets z = x^e, where e is the big-endian exponent, with a fixed
+// window of 4 bits and table lookups that do not depend on e. Its running
+// time depends only on the length of e.
+func (f *Field) ExpLimbs(z, x []uint64, e []byte) {
+	n := f.n
+	var buf [18 * MaxLimbs]uint64
+	var b []uint64
+	if 18*n <= len(buf) {
+		b = buf[:18*n]
+	} else {
+		b = make([]uint64, 18*n)
+	}
+	var table [16][]uint64
+	for i := range table {
+		table[i] = b[i*n : (i+1)*n]
+	}
+	acc, t := b[16*n:17*n], b[17*n:]
+
+	copy(table[0], f.one)
+	copy(table[1], x)
+	for i := 2; i < len(table); i++ {
+		f.MulLimbs(table[i], table[i-1], x)
+	}
+
+	copy(acc, f.one)
+	for _, c := range e {
+		for _, w := range [2]uint64{uint64(c >> 4), uint64(c & 0xf)} {
+			for k := 0; k < 4; k++ {
+				f.MulLimbs(acc, acc, acc)
+			}
+			for i := range table {
+				SelectLimbs(t, table[i], t, IsZeroWord(uint64(i)^w))
+			}
+			f.MulLimbs(acc, acc, t)
+		}
+	}
+	copy(z, acc)
+}
+
+// SetBytesLimbs sets z to the Montgomery form of the big-endian integer b
+// of ByteLen bytes, and returns false if it is not reduced modulo m, in
+// which case z is left unchanged.
+func (f *Field) SetBytesLimbs(z []uint64, b []byte) bool {
+	if len(b) != f.byteLn {
+		return false
+	}
+	buf := make([]byte, 8*f.n)
+	copy(buf[len(buf)-len(b):], b)
+	x := make([]uint64, f.n)
+	limbsFromBytes(x, buf)
+	if limbsLess(x, f.m) == 0 {
+		return false
+	}
+	f.toMont(z, x)
+	return true
+}
+
+// SetBytesWideLimbs sets z to the Montgomery form of the big-endian integer
+// b of any length reduced modulo m, in time that only depends on the length
+// of b.
+func (f *Field) SetBytesWideLimbs(z []uint64, b []byte) {
+	// Split b into chunks of n limbs, and compute the sum of the chunks
+	// times the powers of R with Horner's method.
+	chunk := 8 * f.n
+	pad := (chunk - len(b)%chunk) % chunk
+	padded := make([]byte, pad+len(b))
+	copy(padded[pad:], b)
+
+	c := make([]uint64, f.n)
+	for i := range z {
+		z[i] = 0
+	}
+	for len(padded) > 0 {
+		limbsFromBytes(c, padded[:chunk])
+		f.toMont(c, c)
+		f.MulLimbs(z, z, f.rr)
+		f.AddLimbs(z, z, c)
+		padded = padded[chunk:]
+	}
+}
+
+// SetBigLimbs sets z to the Montgomery form of n mod m. This conversion is
+// not constant time.
+func (f *Field) SetBigLimbs(z []uint64, n *big.Int) {
+	f.toMont(z, f.limbs(new(big.Int).Mod(n, f.big)))
+}
+
+// BytesLimbs returns the big-endian encoding of the integer x represents,
+// of ByteLen bytes.
+func (f *Field) BytesLimbs(x []uint64) []byte {
+	r := make([]uint64, f.n)
+	f.fromMont(r, x)
+	b := make([]byte, 8*f.n)
+	limbsToBytes(b, r)
+	return b[len(b)-f.byteLn:]
+}
+
+// IsOddLimbs returns 1 if the integer x represents is odd, and 0 otherwise.
+func (f *Field) IsOddLimbs(x []uint64) uint64 {
+	var buf [scratchLimbs]uint64
+	r := scratch(&buf, f.n)
+	f.fromMont(r, x)
+	return r[0] & 1
+}
+
+// SelectLimbs sets z to x if c is 1, or to y if c is 0.
+func SelectLimbs(z, x, y []uint64, c uint64) {
+	mask := -c
+	for i := range z {
+		z[i] = x[i]&mask | y[i]&^mask
+	}
+}
+
+// EqualLimbs returns 1 if x = y, and 0 otherwise.
+func EqualLimbs(x, y []uint64) uint64 {
+	var acc uint64
+	for i := range x {
+		acc |= x[i] ^ y[i]
+	}
+	return IsZeroWord(acc)
+}
+
+// scratchLimbs is the number of limbs of the temporary values that are
+// kept on the stack, enough for the fields of Elements. The temporary
+// values of larger fields are allocated.
+const scratchLimbs = MaxLimbs + 2
+
+// scratch returns n zero limbs from buf, which must be zero, or from the
+// heap if buf is too short.
+func scratch(buf *[scratchLimbs]uint64, n int) []uint64 {
+	if n > len(buf) {
+		return make([]uint64, n)
+	}
+	return buf[:n]
+}
+
+// madd returns the high and low words of x*y + a + c.
+func madd(x, y, a, c uint64) (hi, lo uint64) {
+	var cc uint64
+	hi, lo = bits.Mul64(x, y)
+	lo, cc = bits.Add64(lo, a, 0)
+	hi += cc
+	lo, cc = bits.Add64(lo, c, 0)
+	hi += cc
+	return hi, lo
+}
+
+// reduce sets z to the integer x + hi*R, which must be smaller than 2m,
+// reduced modulo m. z can alias x.
+func (f *Field) reduce(z, x []uint64, hi uint64) {
+	var buf [scratchLimbs]uint64
+	d := scratch(&buf, f.n)
+	var b uint64
+	for i := range d {
+		d[i], b = bits.Sub64(x[i], f.m[i], b)
+	}
+	// Keep x - m unless the subtraction borrowed from hi.
+	_, b = bits.Sub64(hi, 0, b)
+	SelectLimbs(z, x, d, b)
+}
+
+// toMont sets z to the Montgomery form of x, any integer smaller than R.
+func (f *Field) toMont(z, x []uint64) {
+	f.MulLimbs(z, x, f.rr)
+}
+
+// fromMont sets z to the integer x represents.
+func (f *Field) fromMont(z, x []uint64) {
+	var buf [scratchLimbs]uint64
+	u := scratch(&buf, f.n)
+	u[0] = 1
+	f.MulLimbs(z, x, u)
+}
+
+// limbsLess returns 1 if x < y and 0 otherwise.
+func limbsLess(x, y []uint64) uint64 {
+	var b uint64
+	for i := range x {
+		_, b = bits.Sub64(x[i], y[i], b)
+	}
+	return b
+}
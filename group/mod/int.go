@@ -294,6 +294,19 @@ func (i *Int) Sqrt(as kyber.Scalar) bool {
 	return out != nil
 }
 
+// Legendre returns the Legendre symbol of i modulo M, which indicates
+// whether i is zero (0), a non-zero square (1), or a non-square (-1).
+// Assumes the modulus M is an odd prime.
+func (i *Int) Legendre() int {
+	return big.Jacobi(&i.V, i.M)
+}
+
+// SetBytesWide sets i to the integer a of any length, reduced modulo M.
+// It is the same as SetBytes, which already accepts wide inputs.
+func (i *Int) SetBytesWide(a []byte) kyber.Scalar {
+	return i.SetBytes(a)
+}
+
 // Pick a [pseudo-]random integer modulo M
 // using bits from the given stream cipher.
 func (i *Int) Pick(rand cipher.Stream) kyber.Scalar {
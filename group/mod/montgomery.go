@@ -0,0 +1,52 @@
+package mod
+
+import (
+	"math/big"
+
+	"github.com/dedis/kyber/group/internal/montgomery"
+)
+
+// Field holds the precomputed values for arithmetic modulo an odd modulus
+// M in Montgomery representation, in which an integer x is stored as
+// x*R mod M, with R = 2^(64n) for the n 64-bit limbs needed to hold M.
+// Products are then reduced with multiplications and shifts instead of
+// divisions, and every operation runs in a time that depends only on the
+// size of M, not on the values involved. The arithmetic is the one that the
+// coordinates of the constant-time curves of this library use.
+//
+// A Field is immutable once created and can be shared by any number of
+// MontInts and goroutines.
+type Field struct {
+	M *big.Int // Modulus of the field, which must not be modified
+
+	mf     *montgomery.Field
+	sqrtM1 []uint64 // A square root of -1 if M is 5 mod 8, for Sqrt
+}
+
+// NewField returns the Field of the integers modulo m, which must be odd
+// and greater than 1. The Field keeps a copy of m.
+func NewField(m *big.Int) *Field {
+	if m.Sign() <= 0 || m.Bit(0) == 0 || m.Cmp(one) == 0 {
+		panic("mod: Montgomery modulus must be odd and greater than 1")
+	}
+	f := &Field{M: new(big.Int).Set(m), mf: montgomery.NewField(m)}
+
+	// 2^((M-1)/4) is a square root of -1 if M is a prime 5 mod 8, since 2
+	// is then a non-square.
+	if m.Bit(1) == 0 && m.Bit(2) == 1 {
+		f.sqrtM1 = f.limbs()
+		f.mf.SetBigLimbs(f.sqrtM1, two)
+		f.mf.ExpLimbs(f.sqrtM1, f.sqrtM1, new(big.Int).Rsh(m, 2).Bytes())
+	}
+	return f
+}
+
+// String returns the modulus in hexadecimal.
+func (f *Field) String() string {
+	return f.M.Text(16)
+}
+
+// limbs returns a new element of the Field, set to 0.
+func (f *Field) limbs() []uint64 {
+	return make([]uint64, f.mf.Limbs())
+}
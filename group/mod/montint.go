@@ -0,0 +1,321 @@
+package mod
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
+)
+
+// MontInt is an element of the integers modulo the odd modulus of a Field,
+// stored in Montgomery representation in fixed-size limbs.
+// MontInt satisfies the kyber.Scalar and kyber.ExtendedScalar interfaces,
+// and can replace Int wherever the modulus is odd: it has the same
+// encoding, but its arithmetic is faster and runs in constant time,
+// except for the conversions from and to big.Int, and for Sqrt for some
+// moduli.
+//
+// As with Int, binary operations assume that their operands have the same
+// Field, and operations may be performed on uninitialized targets, which
+// then receive the Field of the first operand.
+type MontInt struct {
+	f  *Field
+	v  []uint64
+	BO ByteOrder // Endianness which will be used on input and output
+}
+
+// NewMontInt creates a new MontInt of the Field f with the value v mod M.
+func NewMontInt(v *big.Int, f *Field) *MontInt {
+	return new(MontInt).Init(v, f)
+}
+
+// NewMontInt64 creates a new MontInt of the Field f with the value v mod M.
+func NewMontInt64(v int64, f *Field) *MontInt {
+	return new(MontInt).Init(big.NewInt(v), f)
+}
+
+// Init sets the Field of the MontInt to f, and its value to v mod M.
+func (i *MontInt) Init(v *big.Int, f *Field) *MontInt {
+	i.init(f)
+	i.BO = BigEndian
+	return i.SetBigInt(v)
+}
+
+// init sets the Field of i, allocating its limbs if needed.
+func (i *MontInt) init(f *Field) {
+	i.f = f
+	if len(i.v) != f.mf.Limbs() {
+		i.v = f.limbs()
+	}
+}
+
+// Field returns the Field of the MontInt.
+func (i *MontInt) Field() *Field {
+	return i.f
+}
+
+// SetBigInt sets the MontInt to v mod M. This conversion is not constant
+// time.
+func (i *MontInt) SetBigInt(v *big.Int) *MontInt {
+	i.f.mf.SetBigLimbs(i.v, v)
+	return i
+}
+
+// BigInt returns the value of the MontInt as a big.Int, from 0 through
+// M-1. This conversion is not constant time.
+func (i *MontInt) BigInt() *big.Int {
+	return new(big.Int).SetBytes(i.f.mf.BytesLimbs(i.v))
+}
+
+// String returns the value of the MontInt in hexadecimal, as Int does.
+func (i *MontInt) String() string {
+	return hex.EncodeToString(i.BigInt().Bytes())
+}
+
+// Equal returns true if the two MontInts are equal.
+func (i *MontInt) Equal(s2 kyber.Scalar) bool {
+	return montgomery.EqualLimbs(i.v, s2.(*MontInt).v) == 1
+}
+
+// Set sets both the value and the Field of i to the ones of a.
+func (i *MontInt) Set(a kyber.Scalar) kyber.Scalar {
+	ai := a.(*MontInt)
+	i.init(ai.f)
+	copy(i.v, ai.v)
+	return i
+}
+
+// Clone returns a separate duplicate of this MontInt.
+func (i *MontInt) Clone() kyber.Scalar {
+	ni := &MontInt{BO: i.BO}
+	ni.Set(i)
+	return ni
+}
+
+// Zero sets the MontInt to 0. The Field must already be initialized.
+func (i *MontInt) Zero() kyber.Scalar {
+	for j := range i.v {
+		i.v[j] = 0
+	}
+	return i
+}
+
+// One sets the MontInt to 1. The Field must already be initialized.
+func (i *MontInt) One() kyber.Scalar {
+	i.f.mf.SetOneLimbs(i.v)
+	return i
+}
+
+// SetInt64 sets the MontInt to v mod M. The Field must already be
+// initialized.
+func (i *MontInt) SetInt64(v int64) kyber.Scalar {
+	return i.SetBigInt(big.NewInt(v))
+}
+
+// Add sets the target to a + b mod M.
+func (i *MontInt) Add(a, b kyber.Scalar) kyber.Scalar {
+	ai, bi := a.(*MontInt), b.(*MontInt)
+	i.init(ai.f)
+	i.f.mf.AddLimbs(i.v, ai.v, bi.v)
+	return i
+}
+
+// Sub sets the target to a - b mod M.
+func (i *MontInt) Sub(a, b kyber.Scalar) kyber.Scalar {
+	ai, bi := a.(*MontInt), b.(*MontInt)
+	i.init(ai.f)
+	i.f.mf.SubLimbs(i.v, ai.v, bi.v)
+	return i
+}
+
+// Neg sets the target to -a mod M.
+func (i *MontInt) Neg(a kyber.Scalar) kyber.Scalar {
+	ai := a.(*MontInt)
+	i.init(ai.f)
+	i.f.mf.SubLimbs(i.v, i.f.limbs(), ai.v)
+	return i
+}
+
+// Mul sets the target to a * b mod M.
+func (i *MontInt) Mul(a, b kyber.Scalar) kyber.Scalar {
+	ai, bi := a.(*MontInt), b.(*MontInt)
+	i.init(ai.f)
+	i.f.mf.MulLimbs(i.v, ai.v, bi.v)
+	return i
+}
+
+// Div sets the target to a * b^-1 mod M. The modulus must be prime.
+func (i *MontInt) Div(a, b kyber.Scalar) kyber.Scalar {
+	var t MontInt
+	t.Inv(b)
+	return i.Mul(a, &t)
+}
+
+// Inv sets the target to the modular inverse of a, computed as a^(M-2) in
+// constant time. The modulus must be prime, and the inverse of 0 is 0.
+func (i *MontInt) Inv(a kyber.Scalar) kyber.Scalar {
+	ai := a.(*MontInt)
+	e := new(big.Int).Sub(ai.f.M, two)
+	i.init(ai.f)
+	i.f.mf.ExpLimbs(i.v, ai.v, e.Bytes())
+	return i
+}
+
+// Exp sets the target to a^e mod M, where e is an arbitrary big.Int
+// exponent. It runs in a time that depends only on the length of e, as long
+// as e is not negative: negative exponents require the modulus to be prime
+// and use Inv.
+func (i *MontInt) Exp(a kyber.Scalar, e *big.Int) kyber.Scalar {
+	ai := a.(*MontInt)
+	if e.Sign() < 0 {
+		var t MontInt
+		t.Inv(ai)
+		return i.Exp(&t, new(big.Int).Neg(e))
+	}
+	i.init(ai.f)
+	i.f.mf.ExpLimbs(i.v, ai.v, e.Bytes())
+	return i
+}
+
+// Legendre returns the Legendre symbol of i modulo M, which indicates
+// whether i is zero (0), a non-zero square (1), or a non-square (-1),
+// with Euler's criterion. Assumes the modulus M is an odd prime.
+func (i *MontInt) Legendre() int {
+	e := new(big.Int).Rsh(i.f.M, 1)
+	t, one := i.f.limbs(), i.f.limbs()
+	i.f.mf.ExpLimbs(t, i.v, e.Bytes())
+	i.f.mf.SetOneLimbs(one)
+	// The result is 1, 0 or -1 mod M for squares, zero and non-squares.
+	isOne := montgomery.EqualLimbs(t, one)
+	isZero := montgomery.EqualLimbs(t, i.f.limbs())
+	return int(2*isOne+isZero) - 1
+}
+
+// Sqrt sets the target to a square root of a and returns true if a is a
+// square modulo M, or returns false otherwise. Assumes the modulus M is an
+// odd prime. Sqrt runs in constant time when M is 3 modulo 4 or 5 modulo 8,
+// and falls back to big.Int otherwise.
+func (i *MontInt) Sqrt(a kyber.Scalar) bool {
+	ai := a.(*MontInt)
+	f := ai.f
+	var e *big.Int
+	switch {
+	case f.M.Bit(1) == 1:
+		// a^((M+1)/4) is a square root of a if a is a square.
+		e = new(big.Int).Add(f.M, one)
+		e.Rsh(e, 2)
+	case f.sqrtM1 != nil:
+		// a^((M+3)/8) is a square root of a or of -a if a is a square,
+		// in which case multiplying it by sqrt(-1) fixes it.
+		e = new(big.Int).Add(f.M, big.NewInt(3))
+		e.Rsh(e, 3)
+	default:
+		r := new(big.Int).ModSqrt(ai.BigInt(), f.M)
+		if r == nil {
+			return false
+		}
+		i.init(f)
+		i.SetBigInt(r)
+		return true
+	}
+
+	r, s := f.limbs(), f.limbs()
+	f.mf.ExpLimbs(r, ai.v, e.Bytes())
+	f.mf.MulLimbs(s, r, r)
+	if f.sqrtM1 != nil {
+		t := f.limbs()
+		f.mf.MulLimbs(t, r, f.sqrtM1)
+		montgomery.SelectLimbs(r, r, t, montgomery.EqualLimbs(s, ai.v))
+		f.mf.MulLimbs(s, r, r)
+	}
+	if montgomery.EqualLimbs(s, ai.v) == 0 {
+		return false
+	}
+	i.init(f)
+	copy(i.v, r)
+	return true
+}
+
+// Select sets the target to a if cond is 1, or to b if cond is 0, in
+// constant time, and returns it.
+func (i *MontInt) Select(a, b *MontInt, cond int) *MontInt {
+	i.init(a.f)
+	montgomery.SelectLimbs(i.v, a.v, b.v, uint64(cond))
+	return i
+}
+
+// IsOdd returns 1 if the integer value of i, from 0 through M-1, is odd, and
+// 0 otherwise, in constant time.
+func (i *MontInt) IsOdd() int {
+	return int(i.f.mf.IsOddLimbs(i.v))
+}
+
+// Pick sets the MontInt to a [pseudo-]random integer modulo M using bits
+// from the given stream cipher.
+func (i *MontInt) Pick(rand cipher.Stream) kyber.Scalar {
+	return i.SetBigInt(random.Int(i.f.M, rand))
+}
+
+// SetBytes sets the MontInt to the integer a of any length reduced modulo
+// M, in the byte order of i, in a time that depends only on the length of a.
+func (i *MontInt) SetBytes(a []byte) kyber.Scalar {
+	if i.BO == LittleEndian {
+		a = reverse(nil, a)
+	}
+	i.f.mf.SetBytesWideLimbs(i.v, a)
+	return i
+}
+
+// SetBytesWide sets i to the integer a of any length, reduced modulo M.
+// It is the same as SetBytes, which already accepts wide inputs.
+func (i *MontInt) SetBytesWide(a []byte) kyber.Scalar {
+	return i.SetBytes(a)
+}
+
+// MarshalSize returns the length in bytes of encoded integers with modulus
+// M, which is the same as for Int.
+func (i *MontInt) MarshalSize() int {
+	return i.f.mf.ByteLen()
+}
+
+// MarshalBinary encodes the value of this MontInt into a byte-slice exactly
+// MarshalSize() bytes long, in the byte order of i.
+func (i *MontInt) MarshalBinary() ([]byte, error) {
+	b := i.f.mf.BytesLimbs(i.v)
+	if i.BO == LittleEndian {
+		b = reverse(nil, b)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary tries to decode a MontInt from a byte-slice buffer.
+// Returns an error if the buffer is not exactly MarshalSize() bytes long
+// or if the contents of the buffer represents an out-of-range integer.
+func (i *MontInt) UnmarshalBinary(buf []byte) error {
+	if len(buf) != i.MarshalSize() {
+		return errors.New("UnmarshalBinary: wrong size buffer")
+	}
+	if i.BO == LittleEndian {
+		buf = reverse(nil, buf)
+	}
+	if !i.f.mf.SetBytesLimbs(i.v, buf) {
+		return errors.New("UnmarshalBinary: value out of range")
+	}
+	return nil
+}
+
+// MarshalTo encodes this MontInt to the given Writer.
+func (i *MontInt) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.ScalarMarshalTo(i, w)
+}
+
+// UnmarshalFrom tries to decode a MontInt from the given Reader.
+func (i *MontInt) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.ScalarUnmarshalFrom(i, r)
+}
@@ -0,0 +1,124 @@
+package mod
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var montModuli = []string{
+	"65535",
+	"18446744073709551557", // 2^64 - 59
+	"57896044618658097711785492504343953926634992332820282019728792003956564819949",                                                                                 // 2^255 - 19
+	"115792089210356248762697446949407573530086143415290314195533631308867097853951",                                                                                // P-256
+	"6864797660130609714981900799081393217269435300143305409394463459185543183397656052122559640661454554977296311391480858037121987999716643812574028291115057151", // 2^521 - 1
+}
+
+func montField(t *testing.T, s string) (*big.Int, *Field) {
+	m, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return m, NewField(m)
+}
+
+// TestMontInt checks the arithmetic of MontInt against the one of Int.
+func TestMontInt(t *testing.T) {
+	rand := random.New()
+	for _, s := range montModuli {
+		m, f := montField(t, s)
+		for k := 0; k < 50; k++ {
+			a := NewInt64(0, m).Pick(rand).(*Int)
+			b := NewInt64(0, m).Pick(rand).(*Int)
+			if k == 0 {
+				a.V.Sub(m, one)
+			}
+			ma, mb := NewMontInt(&a.V, f), NewMontInt(&b.V, f)
+			check := func(op string, x *Int, y kyber.Scalar) {
+				require.Equal(t, 0, x.V.Cmp(y.(*MontInt).BigInt()), "%s modulo %s", op, s)
+			}
+			check("Set", a, ma)
+			check("Add", new(Int).Add(a, b).(*Int), new(MontInt).Add(ma, mb))
+			check("Sub", new(Int).Sub(a, b).(*Int), new(MontInt).Sub(ma, mb))
+			check("Neg", new(Int).Neg(a).(*Int), new(MontInt).Neg(ma))
+			check("Mul", new(Int).Mul(a, b).(*Int), new(MontInt).Mul(ma, mb))
+			check("Exp", new(Int).Exp(a, &b.V).(*Int), new(MontInt).Exp(ma, &b.V))
+
+			enc := append(a.V.Bytes(), b.V.Bytes()...)
+			check("SetBytes", NewInt64(0, m).SetBytes(enc).(*Int), NewMontInt64(0, f).SetBytes(enc))
+
+			buf, err := ma.MarshalBinary()
+			require.NoError(t, err)
+			ibuf, _ := a.MarshalBinary()
+			require.Equal(t, ibuf, buf)
+			mc := NewMontInt64(0, f)
+			require.NoError(t, mc.UnmarshalBinary(buf))
+			require.True(t, mc.Equal(ma))
+			require.Equal(t, a.String(), ma.String())
+
+			if !m.ProbablyPrime(20) {
+				continue
+			}
+			check("Inv", new(Int).Inv(a).(*Int), new(MontInt).Inv(ma))
+			check("Div", new(Int).Div(a, b).(*Int), new(MontInt).Div(ma, mb))
+			require.Equal(t, a.Legendre(), ma.Legendre())
+			r := new(MontInt)
+			require.Equal(t, new(Int).Sqrt(a), r.Sqrt(ma))
+			if a.Legendre() == 1 {
+				require.True(t, new(MontInt).Mul(r, r).Equal(ma))
+			}
+		}
+	}
+}
+
+func TestMontIntEncoding(t *testing.T) {
+	m, f := montField(t, montModuli[2])
+	i := NewMontInt64(-1, f)
+	assert.Equal(t, 32, i.MarshalSize())
+	assert.Equal(t, 0, i.BigInt().Cmp(new(big.Int).Sub(m, one)))
+
+	// Out of range values are rejected.
+	buf := m.Bytes()
+	assert.Error(t, i.UnmarshalBinary(buf))
+	assert.Error(t, i.UnmarshalBinary(buf[1:]))
+
+	i.BO = LittleEndian
+	j := NewMontInt64(0, f)
+	j.BO = LittleEndian
+	buf, _ = i.MarshalBinary()
+	assert.Equal(t, byte(0x7f), buf[31])
+	assert.Nil(t, j.UnmarshalBinary(buf))
+	assert.True(t, i.Equal(j))
+	assert.True(t, NewMontInt64(1, f).Equal(new(MontInt).Set(j).One()))
+	assert.True(t, new(MontInt).Add(i, NewMontInt64(1, f)).Equal(j.Zero()))
+
+	assert.Panics(t, func() { NewField(big.NewInt(1024)) })
+}
+
+func TestMontIntSelect(t *testing.T) {
+	_, f := montField(t, montModuli[2])
+	a, b := NewMontInt64(3, f), NewMontInt64(4, f)
+	assert.True(t, new(MontInt).Select(a, b, 1).Equal(a))
+	assert.True(t, new(MontInt).Select(a, b, 0).Equal(b))
+	assert.Equal(t, 1, a.IsOdd())
+	assert.Equal(t, 0, b.IsOdd())
+	assert.Equal(t, 0, NewMontInt64(-1, f).IsOdd())
+}
+
+func BenchmarkIntMul(b *testing.B) {
+	m, _ := new(big.Int).SetString(montModuli[3], 10)
+	x := NewInt64(0, m).Pick(random.New())
+	for i := 0; i < b.N; i++ {
+		x.Mul(x, x)
+	}
+}
+
+func BenchmarkMontIntMul(b *testing.B) {
+	m, _ := new(big.Int).SetString(montModuli[3], 10)
+	x := NewMontInt64(0, NewField(m)).Pick(random.New())
+	for i := 0; i < b.N; i++ {
+		x.Mul(x, x)
+	}
+}
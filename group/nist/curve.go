@@ -12,6 +12,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/msm"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -57,6 +58,17 @@ func (p *curvePoint) Valid() bool {
 		(p.x.Sign() == 0 && p.y.Sign() == 0)
 }
 
+// IsSmallOrder reports whether p is the point at infinity,
+// the only point of small order of the curve.
+func (p *curvePoint) IsSmallOrder() bool {
+	return p.x.Sign() == 0 && p.y.Sign() == 0
+}
+
+// ClearCofactor sets p to q, since the curve has prime order.
+func (p *curvePoint) ClearCofactor(q kyber.Point) kyber.Point {
+	return p.Set(q)
+}
+
 // Try to generate a point on this curve from a chosen x-coordinate,
 // with a random sign.
 func (p *curvePoint) genPoint(x *big.Int, rand cipher.Stream) bool {
@@ -171,6 +183,16 @@ func (p *curvePoint) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
 	return p
 }
 
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point.
+func (p *curvePoint) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	v := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		v[i] = &s.(*mod.Int).V
+	}
+	return msm.MultiMulInt(p, v, points)
+}
+
 func (p *curvePoint) MarshalSize() int {
 	coordlen := (p.c.Params().BitSize + 7) >> 3
 	return 1 + 2*coordlen // uncompressed ANSI X9.62 representation
@@ -220,6 +242,7 @@ type curve struct {
 	elliptic.Curve
 	curveOps
 	p *elliptic.CurveParams
+	z *big.Int // Z parameter of the simplified SWU map used for hiding
 }
 
 // Return the number of bytes in the encoding of a Scalar for this curve.
@@ -228,7 +251,8 @@ func (c *curve) ScalarLen() int { return (c.p.N.BitLen() + 7) / 8 }
 // Create a Scalar associated with this curve. The scalars created by
 // this package implement kyber.Scalar's SetBytes method, interpreting
 // the bytes as a big-endian integer, so as to be compatible with the
-// Go standard library's big.Int type.
+// Go standard library's big.Int type. They are mod.Ints, whose arithmetic
+// runs in variable time like the rest of these curves.
 func (c *curve) Scalar() kyber.Scalar {
 	return mod.NewInt64(0, c.p.N)
 }
@@ -266,3 +290,14 @@ func (p *curvePoint) Clone() kyber.Point {
 func (c *curve) Order() *big.Int {
 	return c.p.N
 }
+
+// Return the cofactor of this curve, which is 1 for the NIST curves.
+func (c *curve) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+// Return true: decoding checks that points are on the curve,
+// which has prime order.
+func (c *curve) IsPrimeOrder() bool {
+	return true
+}
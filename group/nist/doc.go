@@ -1,5 +1,9 @@
 // Package nist implements cryptographic groups and ciphersuites
-// based on the NIST standards, using Go's built-in crypto library.
-// Since that package does not implement constant time arithmetic operations
-// yet, it must be compiled with the "vartime" compilation flag.
+// based on the NIST standards.
+// The P-256 group is implemented in constant time by this package's own
+// arithmetic, with only base point multiplications left to Go's crypto/ecdh,
+// and is always available.
+// The other groups rely on Go's built-in crypto library and on arithmetic
+// operations that are not constant time, so they must be compiled with the
+// "vartime" compilation flag.
 package nist
@@ -0,0 +1,104 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestFFDHGroups(t *testing.T) {
+	for _, bits := range []int{2048, 3072, 4096, 6144, 8192} {
+		for _, get := range []func(int) (*ResidueGroup, error){MODP, FFDHE} {
+			g, err := get(bits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g.P.BitLen() != bits || g.Q.BitLen() != bits-1 {
+				t.Fatalf("%s: wrong size", g)
+			}
+			// The generator 2 must be a quadratic residue. Checking the
+			// primality of the larger P and Q takes too long for a test.
+			if new(big.Int).Exp(g.G, g.Q, g.P).Cmp(one) != 0 {
+				t.Fatalf("%s: generator is not of order Q", g)
+			}
+			if bits == 2048 && !g.Valid() {
+				t.Fatalf("%s: invalid parameters", g)
+			}
+		}
+	}
+	if _, err := FFDHE(1024); err == nil {
+		t.Fatal("unexpected 1024-bit ffdhe group")
+	}
+	if g, _ := MODP(2048); g.String() != "modp2048" {
+		t.Fatal("wrong group name", g.String())
+	}
+}
+
+func TestFFDHESuite(t *testing.T) {
+	suite, err := NewBlakeSHA256FFDHE(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suite.String() != "ffdhe2048" {
+		t.Fatal("wrong suite name", suite.String())
+	}
+	a := suite.Scalar().Pick(suite.RandomStream())
+	b := suite.Scalar().Pick(suite.RandomStream())
+	A := suite.Point().Mul(a, nil)
+	B := suite.Point().Mul(b, nil)
+	if !suite.Point().Mul(a, B).Equal(suite.Point().Mul(b, A)) {
+		t.Fatal("Diffie-Hellman shared secrets differ")
+	}
+	buf, _ := A.MarshalBinary()
+	if err := suite.Point().UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	P := suite.Point().Embed([]byte("kyber"), suite.RandomStream())
+	if data, err := P.Data(); err != nil || string(data) != "kyber" {
+		t.Fatal("embedding failed")
+	}
+}
+
+func TestFIPS186(t *testing.T) {
+	g := new(ResidueGroup)
+	s, err := g.DSAGroup(1024, 160, 1, sha256.New, random.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.Valid() {
+		t.Fatal("invalid DSA group")
+	}
+	if err := g.ValidateFIPS186(s); err != nil {
+		t.Fatal(err)
+	}
+
+	s2 := *s
+	s2.Counter++
+	if g.ValidateFIPS186(&s2) == nil {
+		t.Fatal("wrong counter accepted")
+	}
+	s2 = *s
+	s2.Index++
+	if g.ValidateFIPS186(&s2) == nil {
+		t.Fatal("wrong generator index accepted")
+	}
+	s2 = *s
+	s2.Seed = append([]byte{}, s.Seed...)
+	s2.Seed[0] ^= 1
+	if g.ValidateFIPS186(&s2) == nil {
+		t.Fatal("wrong seed accepted")
+	}
+	g2 := *g
+	g2.G = new(big.Int).Exp(g.G, two, g.P)
+	if g2.ValidateFIPS186(s) == nil {
+		t.Fatal("wrong generator accepted")
+	}
+
+	if _, err := g.DSAGroup(1024, 256, 1, sha256.New, random.New()); err == nil {
+		t.Fatal("sizes not allowed by FIPS 186-4 accepted")
+	}
+}
@@ -0,0 +1,204 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/cipher"
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// DSASeed holds the values that prove that DSA domain parameters were
+// generated from a seed by the methods of FIPS 186-4, appendices A.1.1.2
+// and A.2.3, so that nobody could choose them to hide a trapdoor.
+type DSASeed struct {
+	// Hash is the approved hash function used for the generation, whose
+	// output must be at least as long as Q.
+	Hash func() hash.Hash
+	// Seed is the domain_parameter_seed, at least as long as Q.
+	Seed []byte
+	// Counter is the index of the first candidate for P that is prime.
+	Counter int
+	// Index identifies the generator G among the ones that can be
+	// derived from Seed.
+	Index byte
+}
+
+// ggen is the string that separates the hashes of the generators from the
+// ones of the primes.
+var ggen = []byte("ggen")
+
+// checkDSASizes checks that the sizes of P and Q are one of the pairs
+// allowed by FIPS 186-4, and that they fit the hash function and seed.
+func checkDSASizes(L, N int, s *DSASeed) error {
+	switch {
+	case L == 1024 && N == 160:
+	case L == 2048 && (N == 224 || N == 256):
+	case L == 3072 && N == 256:
+	default:
+		return errors.New("nist: DSA prime sizes not allowed by FIPS 186-4")
+	}
+	if s.Hash().Size()*8 < N {
+		return errors.New("nist: DSA hash function too short")
+	}
+	if len(s.Seed)*8 < N {
+		return errors.New("nist: DSA seed too short")
+	}
+	return nil
+}
+
+// dsaHash returns the hash of the concatenation of data as an integer.
+func dsaHash(h func() hash.Hash, data ...[]byte) *big.Int {
+	d := h()
+	for _, b := range data {
+		d.Write(b)
+	}
+	return new(big.Int).SetBytes(d.Sum(nil))
+}
+
+// dsaQ returns the candidate for Q derived from seed, of N bits.
+func dsaQ(N int, s *DSASeed) *big.Int {
+	// q = 2^(N-1) + U + 1 - (U mod 2), with U = Hash(seed) mod 2^(N-1).
+	top := new(big.Int).Lsh(one, uint(N-1))
+	U := dsaHash(s.Hash, s.Seed)
+	U.Mod(U, top)
+	q := U.Add(U, top)
+	return q.SetBit(q, 0, 1)
+}
+
+// dsaP returns the candidate for P of L bits derived from seed and the
+// given offset, or nil if the candidate is too small.
+func dsaP(L int, q *big.Int, s *DSASeed, offset int) *big.Int {
+	outlen := s.Hash().Size() * 8
+	n := (L+outlen-1)/outlen - 1
+	b := L - 1 - n*outlen
+
+	seedlen := len(s.Seed) * 8
+	mask := new(big.Int).Lsh(one, uint(seedlen))
+	mask.Sub(mask, one)
+	seed := new(big.Int).SetBytes(s.Seed)
+	buf := make([]byte, len(s.Seed))
+
+	W := new(big.Int)
+	for j := 0; j <= n; j++ {
+		v := new(big.Int).Add(seed, big.NewInt(int64(offset+j)))
+		v.And(v, mask).FillBytes(buf)
+		V := dsaHash(s.Hash, buf)
+		if j == n {
+			V.And(V, new(big.Int).Sub(new(big.Int).Lsh(one, uint(b)), one))
+		}
+		W.Add(W, V.Lsh(V, uint(j*outlen)))
+	}
+	X := W.SetBit(W, L-1, 1)
+	c := new(big.Int).Mod(X, new(big.Int).Lsh(q, 1))
+	p := X.Sub(X, c.Sub(c, one))
+	if p.BitLen() < L {
+		return nil
+	}
+	return p
+}
+
+// dsaG returns the generator of index s.Index and the given count derived
+// from the seed, or nil if the candidate is smaller than 2.
+func dsaG(p, e *big.Int, s *DSASeed, count uint16) *big.Int {
+	W := dsaHash(s.Hash, s.Seed, ggen, []byte{s.Index, byte(count >> 8), byte(count)})
+	g := W.Exp(W, e, p)
+	if g.Cmp(one) <= 0 {
+		return nil
+	}
+	return g
+}
+
+// DSAGroup initializes a ResidueGroup with DSA domain parameters of L and N
+// bits for P and Q, generated from a random seed of N bits with the
+// approved hash function h, and returns the values with which
+// ValidateFIPS186 checks them. The generator G is the canonical one of the
+// given index. L and N must be 1024 and 160, 2048 and 224, 2048 and 256, or
+// 3072 and 256.
+func (g *ResidueGroup) DSAGroup(L, N int, index byte, h func() hash.Hash, rand cipher.Stream) (*DSASeed, error) {
+	s := &DSASeed{Hash: h, Seed: make([]byte, (N+7)/8), Index: index}
+	if err := checkDSASizes(L, N, s); err != nil {
+		return nil, err
+	}
+	outlen := h().Size() * 8
+	n := (L+outlen-1)/outlen - 1
+	for {
+		random.Bytes(s.Seed, rand)
+		q := dsaQ(N, s)
+		if !isPrime(q) {
+			continue
+		}
+		offset := 1
+		for s.Counter = 0; s.Counter < 4*L; s.Counter++ {
+			p := dsaP(L, q, s, offset)
+			offset += n + 1
+			if p == nil || !isPrime(p) {
+				continue
+			}
+			g.P, g.Q = p, q
+			g.R = new(big.Int).Div(new(big.Int).Sub(p, one), q)
+			g.name = ""
+			for count := uint16(1); ; count++ {
+				if g.G = dsaG(p, g.R, s, count); g.G != nil {
+					return s, nil
+				}
+			}
+		}
+	}
+}
+
+// ValidateFIPS186 checks that the parameters of the group were generated
+// from the seed s by the methods of FIPS 186-4: it validates the primes P and
+// Q as in appendix A.1.1.3, and the canonical generator G as in appendix
+// A.2.3. It returns an error if any of these checks fails.
+func (g *ResidueGroup) ValidateFIPS186(s *DSASeed) error {
+	L, N := g.P.BitLen(), g.Q.BitLen()
+	if err := checkDSASizes(L, N, s); err != nil {
+		return err
+	}
+	if s.Counter < 0 || s.Counter > 4*L-1 {
+		return errors.New("nist: DSA counter out of range")
+	}
+	q := dsaQ(N, s)
+	if q.Cmp(g.Q) != 0 || !isPrime(q) {
+		return errors.New("nist: DSA prime Q does not match the seed")
+	}
+
+	outlen := s.Hash().Size() * 8
+	n := (L+outlen-1)/outlen - 1
+	offset := 1
+	var p *big.Int
+	i := 0
+	for ; i <= s.Counter; i++ {
+		p = dsaP(L, q, s, offset)
+		if p != nil && isPrime(p) {
+			break
+		}
+		offset += n + 1
+	}
+	if i != s.Counter || p == nil || p.Cmp(g.P) != 0 {
+		return errors.New("nist: DSA prime P does not match the seed")
+	}
+
+	// Partial validation of G, then check that it is derived from the seed.
+	e := new(big.Int).Div(new(big.Int).Sub(g.P, one), g.Q)
+	if g.R == nil || e.Cmp(g.R) != 0 {
+		return errors.New("nist: DSA cofactor R does not match P and Q")
+	}
+	if g.G.Cmp(two) < 0 || g.G.Cmp(g.P) >= 0 ||
+		new(big.Int).Exp(g.G, g.Q, g.P).Cmp(one) != 0 {
+		return errors.New("nist: DSA generator G is not of order Q")
+	}
+	for count := uint16(1); count != 0; count++ {
+		if c := dsaG(g.P, e, s, count); c != nil {
+			if c.Cmp(g.G) != 0 {
+				return errors.New("nist: DSA generator G does not match the seed")
+			}
+			return nil
+		}
+	}
+	return errors.New("nist: no DSA generator derived from the seed")
+}
@@ -3,8 +3,12 @@
 package nist
 
 import (
+	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/util/test"
 )
 
@@ -12,9 +16,13 @@ var testQR512 = NewBlakeSHA256QR512()
 
 func TestQR512(t *testing.T) { test.SuiteTest(testQR512) }
 
-var testP256 = NewBlakeSHA256P256()
+var testP384 = NewShakeSHA384P384()
 
-func TestP256(t *testing.T) { test.SuiteTest(testP256) }
+func TestP384(t *testing.T) { test.SuiteTest(testP384) }
+
+var testP521 = NewShakeSHA512P521()
+
+func TestP521(t *testing.T) { test.SuiteTest(testP521) }
 
 func TestSetBytesBE(t *testing.T) {
 	s := testP256.Scalar()
@@ -26,6 +34,59 @@ func TestSetBytesBE(t *testing.T) {
 	}
 }
 
+func TestP256Hiding(t *testing.T) {
+	c := &p256Hide
+
+	// u[0] and Q0 of the first P256_XMD:SHA-256_SSWU_RO_ test vector of
+	// RFC 9380, appendix J.1.1.
+	u, _ := new(big.Int).SetString("ad5342c66a6dd0ff080df1da0ea1c04b96e0330dd89406465eeba11582515009", 16)
+	x, y := c.mapToCurve(u)
+	if fmt.Sprintf("%x,%x", x, y) != "ab640a12220d3ff283510ff3f4b1953d09fad35795140b1c5d64f313967934d5,"+
+		"dccb558863804a881d4fff3455716c836cef230e5209594ddd33d85c565b19b1" {
+		t.Fatal("wrong simplified SWU map")
+	}
+
+	testHiding(t, testP256, c)
+}
+
+func TestP384Hiding(t *testing.T) { testHiding(t, testP384, &testP384.curve) }
+
+func TestP521Hiding(t *testing.T) { testHiding(t, testP521, &testP521.curve) }
+
+// testHiding checks the preimages of the simplified SWU map of c and the
+// uniform encoding of the points of g.
+func testHiding(t *testing.T, g kyber.Group, c *curve) {
+	rand := random.New()
+	for i := 0; i < 10; i++ {
+		// Every preimage of f(u) is found exactly once, including u.
+		u := random.Int(c.p.P, rand)
+		x, y := c.mapToCurve(u)
+		if !c.IsOnCurve(x, y) {
+			t.Fatal("simplified SWU map gave a point off the curve")
+		}
+		found := 0
+		for j := 0; j < sswuMaxPreimages; j++ {
+			if v := c.sswuPreimage(x, y, j); v != nil && v.Cmp(u) == 0 {
+				found++
+			}
+		}
+		if found != 1 {
+			t.Fatalf("found u %d times among the preimages of f(u)", found)
+		}
+
+		p := g.Point().Pick(rand)
+		rep := p.(kyber.Hiding).HideEncode(rand)
+		if len(rep) != p.(kyber.Hiding).HideLen() {
+			t.Fatal("wrong length of hidden encoding")
+		}
+		q := g.Point()
+		q.(kyber.Hiding).HideDecode(rep)
+		if !p.Equal(q) {
+			t.Fatal("hidden encoding did not decode to the same point")
+		}
+	}
+}
+
 var benchP256 = test.NewGroupBench(testP256)
 
 func BenchmarkScalarAdd(b *testing.B)    { benchP256.ScalarAdd(b.N) }
@@ -0,0 +1,266 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// This file implements the kyber.Hiding interface for NIST curve points with
+// Elligator Squared, from "Elligator Squared: Uniform Points on Elliptic
+// Curves of Prime Order as Uniform Random Strings" by Mehdi Tibouchi
+// (https://eprint.iacr.org/2014/043). A point P is represented by two field
+// elements u1 and u2 such that P = f(u1) + f(u2), where f is the simplified
+// SWU map of RFC 9380, section 6.6.2. Picking u1 at random, and u2 at random
+// among the preimages of P - f(u1) with a probability proportional to their
+// number, yields a pair that is statistically close to uniform whatever P
+// is, so that encoding never fails. Each field element is then written as a
+// random integer congruent to it modulo p, 16 bytes longer than a
+// coordinate, which makes the whole representation close to a uniform
+// string.
+
+// sswuMaxPreimages bounds the number of preimages of a point under the
+// simplified SWU map: at most two for each of its two candidate
+// x-coordinates.
+const sswuMaxPreimages = 4
+
+// Number of bytes of the representation of one field element.
+func (c *curve) hideFieldLen() int {
+	return c.coordLen() + 16
+}
+
+// HideLen returns the length of the uniform representations of points.
+func (p *curvePoint) HideLen() int {
+	return 2 * p.c.hideFieldLen()
+}
+
+// HideEncode returns a uniform representation of p. It never fails.
+func (p *curvePoint) HideEncode(rand cipher.Stream) []byte {
+	c := p.c
+	P := c.p.P
+	l := c.hideFieldLen()
+	for {
+		u1 := random.Int(P, rand)
+		x, y := c.mapToCurve(u1)
+		x, y = c.Add(p.x, p.y, x, new(big.Int).Sub(P, y))
+		if x.Sign() == 0 && y.Sign() == 0 {
+			continue
+		}
+		j := int(random.Int(big.NewInt(sswuMaxPreimages), rand).Int64())
+		if u2 := c.sswuPreimage(x, y, j); u2 != nil {
+			rep := make([]byte, 2*l)
+			c.hideField(rep[:l], u1, rand)
+			c.hideField(rep[l:], u2, rand)
+			return rep
+		}
+	}
+}
+
+// HideDecode sets p to the point represented by rep, which must be
+// HideLen bytes long.
+func (p *curvePoint) HideDecode(rep []byte) {
+	c := p.c
+	P := c.p.P
+	l := c.hideFieldLen()
+	u1 := new(big.Int).SetBytes(rep[:l])
+	u2 := new(big.Int).SetBytes(rep[l:])
+	x1, y1 := c.mapToCurve(u1.Mod(u1, P))
+	x2, y2 := c.mapToCurve(u2.Mod(u2, P))
+	p.x, p.y = c.Add(x1, y1, x2, y2)
+}
+
+// hideField writes to out a random integer congruent to u modulo p. Integers
+// are picked below the largest multiple of p that fits, so that their
+// distribution is within 2^-128 of the uniform one when u is uniform.
+func (c *curve) hideField(out []byte, u *big.Int, rand cipher.Stream) {
+	P := c.p.P
+	max := new(big.Int).Lsh(big.NewInt(1), uint(8*len(out)))
+	max.Div(max, P)
+	n := random.Int(max, rand)
+	n.Mul(n, P)
+	n.Add(n, u)
+
+	b := n.Bytes()
+	for i := range out {
+		out[i] = 0
+	}
+	copy(out[len(out)-len(b):], b)
+}
+
+// sqrtChecked returns a square root of a modulo p, or nil if a is not a
+// quadratic residue.
+func (c *curve) sqrtChecked(a *big.Int) *big.Int {
+	P := c.p.P
+	r := c.sqrt(a)
+	r.Mod(r, P)
+	check := new(big.Int).Mul(r, r)
+	if check.Mod(check, P).Cmp(new(big.Int).Mod(a, P)) != 0 {
+		return nil
+	}
+	return r
+}
+
+// rhs returns x^3 - 3x + b modulo p.
+func (c *curve) rhs(x *big.Int) *big.Int {
+	P := c.p.P
+	y2 := new(big.Int).Mul(x, x)
+	y2.Mul(y2, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y2.Sub(y2, threeX)
+	y2.Add(y2, c.p.B)
+	return y2.Mod(y2, P)
+}
+
+// mapToCurve implements the simplified SWU map of RFC 9380, section 6.6.2,
+// from an element u of GF(p) to an affine point of the curve, with A = -3
+// and the curve's parameter Z.
+func (c *curve) mapToCurve(u *big.Int) (x, y *big.Int) {
+	P := c.p.P
+	a := big.NewInt(-3)
+
+	// tv1 = inv0(Z^2 u^4 + Z u^2)
+	zu2 := new(big.Int).Mul(u, u)
+	zu2.Mul(zu2, c.z)
+	zu2.Mod(zu2, P)
+	tv1 := new(big.Int).Mul(zu2, zu2)
+	tv1.Add(tv1, zu2)
+	tv1.Mod(tv1, P)
+
+	// x1 = (-B/A)(1 + tv1), or B/(ZA) if tv1 is zero
+	x1 := new(big.Int)
+	if tv1.Sign() == 0 {
+		x1.Mul(c.z, a)
+		x1.ModInverse(x1.Mod(x1, P), P)
+		x1.Mul(x1, c.p.B)
+	} else {
+		tv1.ModInverse(tv1, P)
+		tv1.Add(tv1, big.NewInt(1))
+		x1.ModInverse(new(big.Int).Mod(a, P), P)
+		x1.Mul(x1, c.p.B)
+		x1.Neg(x1)
+		x1.Mul(x1, tv1)
+	}
+	x1.Mod(x1, P)
+
+	x = x1
+	y = c.sqrtChecked(c.rhs(x1))
+	if y == nil {
+		// x2 = Z u^2 x1
+		x = new(big.Int).Mul(zu2, x1)
+		x.Mod(x, P)
+		y = c.sqrtChecked(c.rhs(x))
+	}
+	if u.Bit(0) != y.Bit(0) {
+		y.Sub(P, y).Mod(y, P)
+	}
+	return x, y
+}
+
+// sswuPreimage returns the j-th preimage of the affine point (x,y) under
+// mapToCurve, for 0 <= j < sswuMaxPreimages, or nil if there is none. Every
+// preimage has exactly one index, that of the first candidate of
+// sswuCandidate equal to it.
+func (c *curve) sswuPreimage(x, y *big.Int, j int) *big.Int {
+	u := c.sswuCandidate(x, y, j)
+	if u == nil {
+		return nil
+	}
+	if qx, qy := c.mapToCurve(u); qx.Cmp(x) != 0 || qy.Cmp(y) != 0 {
+		return nil
+	}
+	for i := 0; i < j; i++ {
+		if v := c.sswuCandidate(x, y, i); v != nil && v.Cmp(u) == 0 {
+			return nil
+		}
+	}
+	return u
+}
+
+// sswuCandidate returns the j-th value of u for which one of the candidate
+// x-coordinates computed by mapToCurve equals x, and whose sign matches that
+// of y, or nil if there is no such value. The first two candidates come from
+// x1 and the next two from x2.
+func (c *curve) sswuCandidate(x, y *big.Int, j int) *big.Int {
+	P := c.p.P
+	one := big.NewInt(1)
+
+	// Both candidates are functions of w = Z u^2 and of k = -Ax/B-1,
+	// since x1 = (-B/A)(1 + 1/(w^2+w)) and x2 = w x1.
+	k := new(big.Int).ModInverse(c.p.B, P)
+	k.Mul(k, x)
+	k.Mul(k, big.NewInt(3))
+	k.Sub(k, one)
+	k.Mod(k, P)
+
+	// For x1, w is a root of w^2 + w - 1/k, and for x2 it is a root of
+	// w^2 - kw - k. In both cases, w = (b ± sqrt(b^2 + 4d))/2.
+	b, d := new(big.Int), new(big.Int)
+	if j/2 == 0 {
+		if d.ModInverse(k, P) == nil {
+			return nil
+		}
+		b.Neg(one)
+	} else {
+		b.Set(k)
+		d.Set(k)
+	}
+	disc := new(big.Int).Mul(b, b)
+	disc.Add(disc, d.Lsh(d, 2))
+	s := c.sqrtChecked(disc.Mod(disc, P))
+	if s == nil {
+		return nil
+	}
+	if j%2 == 1 {
+		s.Neg(s)
+	}
+	w := b.Add(b, s)
+	w.Mul(w, new(big.Int).ModInverse(big.NewInt(2), P))
+
+	// u = ± sqrt(w/Z), with the sign of y.
+	u2 := new(big.Int).ModInverse(c.z, P)
+	u2.Mul(u2, w)
+	u := c.sqrtChecked(u2.Mod(u2, P))
+	if u == nil {
+		return nil
+	}
+	if u.Bit(0) != y.Bit(0) {
+		u.Sub(P, u).Mod(u, P)
+	}
+	return u
+}
+
+// p256Hide is the variable-time P-256 curve on which the uniform
+// representations of the constant-time P-256 points are computed.
+var p256Hide = new(p256Curve).Init()
+
+// toCurvePoint returns p as a point of p256Hide.
+func (p *p256Point) toCurvePoint() *curvePoint {
+	x, y := p.affineInts()
+	return &curvePoint{x: x, y: y, c: &p256Hide}
+}
+
+// HideLen returns the length of the uniform representations of points.
+func (p *p256Point) HideLen() int {
+	return p.toCurvePoint().HideLen()
+}
+
+// HideEncode returns a uniform representation of p. It never fails, but
+// runs in variable time.
+func (p *p256Point) HideEncode(rand cipher.Stream) []byte {
+	return p.toCurvePoint().HideEncode(rand)
+}
+
+// HideDecode sets p to the point represented by rep, which must be
+// HideLen bytes long.
+func (p *p256Point) HideDecode(rep []byte) {
+	q := p256Hide.Point().(*curvePoint)
+	q.HideDecode(rep)
+	buf, _ := q.MarshalBinary()
+	if err := p.UnmarshalBinary(buf); err != nil {
+		panic(err)
+	}
+}
@@ -1,78 +1,483 @@
-// +build vartime
-
 package nist
 
 import (
+	"crypto/cipher"
+	"crypto/ecdh"
 	"crypto/elliptic"
+	"errors"
+	"io"
 	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
 )
 
-// P256 implements the kyber.Group interface
-// for the NIST P-256 elliptic curve,
-// based on Go's native elliptic curve library.
+// p256 implements the kyber.Group interface for the NIST P-256 elliptic
+// curve in constant time. Its arithmetic is this package's own, in the style
+// of the standard library's nistec package, which backs crypto/ecdh but is
+// internal to it: coordinates and scalars are kept in Montgomery form by the
+// montgomery package, points are added with the complete formula for a = -3
+// of "Complete addition formulas for prime order elliptic curves" by Renes,
+// Costello and Batina (https://eprint.iacr.org/2015/1060), algorithm 4, and
+// multiplied with a fixed window over this formula, which MultiMul shares
+// among several points with Straus's method. Only the multiples of the base
+// point computed by Mul are left to crypto/ecdh. Since it does not need the
+// vartime tag, the P-256 suite is available by default.
 type p256 struct {
-	curve
 }
 
-func (curve *p256) String() string {
+var (
+	p256Params = elliptic.P256().Params()
+	p256Field  = montgomery.NewField(p256Params.P)
+	p256Order  = montgomery.NewField(p256Params.N)
+
+	p256SqrtExp = new(big.Int).Rsh(new(big.Int).Add(p256Params.P, big.NewInt(1)), 2)
+
+	// p256B is the constant b of the curve equation y² = x³ - 3x + b. It is
+	// set in a variable declaration rather than in init, so that points can
+	// be picked while initializing package-level variables.
+	p256B = p256Element(p256Params.B)
+)
+
+// p256Element returns the Montgomery form of n mod p.
+func p256Element(n *big.Int) montgomery.Element {
+	var e montgomery.Element
+	p256Field.SetBig(&e, n)
+	return e
+}
+
+func (c *p256) String() string {
 	return "P256"
 }
 
-// Optimized modular square root for P-256 curve, from
-// "Mathematical routines for the NIST prime elliptic curves" (April 2010)
-func (curve *p256) sqrt(c *big.Int) *big.Int {
-	m := curve.p.P
-
-	t1 := new(big.Int)
-	t1.Mul(c, c)
-	t1.Mul(t1, c) // t1 = c^(2^2-1)
-
-	p2 := new(big.Int)
-	p2.SetBit(p2, 2, 1)
-	t2 := new(big.Int)
-	t2.Exp(t1, p2, m)
-	t2.Mul(t2, t1) // t2 = c^(2^4-1)
-
-	p3 := new(big.Int)
-	p3.SetBit(p3, 4, 1)
-	t3 := new(big.Int)
-	t3.Exp(t2, p3, m)
-	t3.Mul(t3, t2) // t3 = c^(2^8-1)
-
-	p4 := new(big.Int)
-	p4.SetBit(p4, 8, 1)
-	t4 := new(big.Int)
-	t4.Exp(t3, p4, m)
-	t4.Mul(t4, t3) // t4 = c^(2^16-1)
-
-	p5 := new(big.Int)
-	p5.SetBit(p5, 16, 1)
-	r := new(big.Int)
-	r.Exp(t4, p5, m)
-	r.Mul(r, t4) // r = c^(2^32-1)
-
-	p6 := new(big.Int)
-	p6.SetBit(p6, 32, 1)
-	r.Exp(r, p6, m)
-	r.Mul(r, c) // r = c^(2^64-2^32+1)
-
-	p7 := new(big.Int)
-	p7.SetBit(p7, 96, 1)
-	r.Exp(r, p7, m)
-	r.Mul(r, c) // r = c^(2^160-2^128+2^96+1)
-
-	p8 := new(big.Int)
-	p8.SetBit(p8, 94, 1)
-	r.Exp(r, p8, m)
-
-	// r = c^(2^254-2^222+2^190+2^94) = sqrt(c) mod p256
-	return r
-}
-
-// Initialize standard Curve instances
-func (c *p256) Init() curve {
-	c.curve.Curve = elliptic.P256()
-	c.p = c.Params()
-	c.curveOps = c
-	return c.curve
+// Return the number of bytes in the encoding of a Scalar for this curve.
+func (c *p256) ScalarLen() int { return (p256Params.N.BitLen() + 7) / 8 }
+
+// Create a Scalar associated with this curve. The scalars created by
+// this package implement kyber.Scalar's SetBytes method, interpreting
+// the bytes as a big-endian integer, so as to be compatible with the
+// Go standard library's big.Int type, and run in constant time.
+func (c *p256) Scalar() kyber.Scalar {
+	return new(p256Scalar)
+}
+
+// Return the number of bytes in the encoding of a Point for this curve,
+// in uncompressed ANSI X9.62 format with both X and Y coordinates.
+func (c *p256) PointLen() int {
+	return 1 + 2*p256CoordLen
+}
+
+// Create a Point associated with this curve, initialized to the point at
+// infinity.
+func (c *p256) Point() kyber.Point {
+	p := new(p256Point)
+	p.Null()
+	return p
+}
+
+// Return the order of this curve: the prime N in the curve parameters.
+func (c *p256) Order() *big.Int {
+	return p256Params.N
+}
+
+// Return the cofactor of this curve, which is 1.
+func (c *p256) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+// Return true: decoding checks that points are on the curve,
+// which has prime order.
+func (c *p256) IsPrimeOrder() bool {
+	return true
+}
+
+const p256CoordLen = 32
+
+// p256Point is a point of P-256 in homogeneous projective coordinates
+// (X:Y:Z), which stand for the affine point (X/Z, Y/Z). The point at
+// infinity is (0:1:0).
+type p256Point struct {
+	x, y, z montgomery.Element
+}
+
+func (p *p256Point) String() string {
+	x, y := p.affineInts()
+	return "(" + x.String() + "," + y.String() + ")"
+}
+
+func (p *p256Point) Equal(p2 kyber.Point) bool {
+	q := p2.(*p256Point)
+	var a, b, c, d montgomery.Element
+	p256Field.Mul(&a, &p.x, &q.z)
+	p256Field.Mul(&b, &q.x, &p.z)
+	p256Field.Mul(&c, &p.y, &q.z)
+	p256Field.Mul(&d, &q.y, &p.z)
+	return montgomery.Equal(&a, &b)&montgomery.Equal(&c, &d) == 1
+}
+
+func (p *p256Point) Null() kyber.Point {
+	p.x = montgomery.Element{}
+	p.y = p256Field.One()
+	p.z = montgomery.Element{}
+	return p
+}
+
+func (p *p256Point) Base() kyber.Point {
+	p256Field.SetBig(&p.x, p256Params.Gx)
+	p256Field.SetBig(&p.y, p256Params.Gy)
+	p.z = p256Field.One()
+	return p
+}
+
+func (p *p256Point) Set(q kyber.Point) kyber.Point {
+	*p = *q.(*p256Point)
+	return p
+}
+
+func (p *p256Point) Clone() kyber.Point {
+	q := *p
+	return &q
+}
+
+func (p *p256Point) EmbedLen() int {
+	// Reserve at least 8 most-significant bits for randomness,
+	// and the least-significant 8 bits for embedded data length.
+	return (p256Params.P.BitLen() - 8 - 8) / 8
+}
+
+func (p *p256Point) Pick(rand cipher.Stream) kyber.Point {
+	return p.Embed(nil, rand)
+}
+
+// Pick a curve point containing a variable amount of embedded data.
+// Remaining bits comprising the point are chosen randomly.
+func (p *p256Point) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	l := p256CoordLen
+	dl := p.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		b := random.Bits(uint(p256Params.P.BitLen()), false, rand)
+		if data != nil {
+			b[l-1] = byte(dl)         // Encode length in low 8 bits
+			copy(b[l-dl-1:l-1], data) // Copy in data to embed
+		}
+		var x, y montgomery.Element
+		if !p256Field.SetBytes(&x, b) || !p256Y(&y, &x) {
+			continue
+		}
+
+		// Pick a random sign for the y coordinate
+		var sign [1]byte
+		rand.XORKeyStream(sign[:], sign[:])
+		var negY montgomery.Element
+		p256Field.Neg(&negY, &y)
+		montgomery.CMove(&y, &negY, uint64(sign[0]>>7))
+
+		p.x, p.y, p.z = x, y, p256Field.One()
+		return p
+	}
+}
+
+// Extract embedded data from a curve point
+func (p *p256Point) Data() ([]byte, error) {
+	l := p256CoordLen
+	var x, y montgomery.Element
+	p.affine(&x, &y)
+	b := p256Field.Bytes(&x)
+	dl := int(b[l-1])
+	if dl > p.EmbedLen() {
+		return nil, errors.New("invalid embedded data length")
+	}
+	return b[l-dl-1 : l-1], nil
+}
+
+func (p *p256Point) Add(a, b kyber.Point) kyber.Point {
+	p.add(a.(*p256Point), b.(*p256Point))
+	return p
+}
+
+func (p *p256Point) Sub(a, b kyber.Point) kyber.Point {
+	var q p256Point
+	q.Neg(b)
+	p.add(a.(*p256Point), &q)
+	return p
+}
+
+func (p *p256Point) Neg(a kyber.Point) kyber.Point {
+	q := a.(*p256Point)
+	p.x = q.x
+	p256Field.Neg(&p.y, &q.y)
+	p.z = q.z
+	return p
+}
+
+// Mul sets p to s times b, or s times the base point if b is nil, in
+// constant time.
+func (p *p256Point) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
+	k := p256Order.Bytes(&s.(*p256Scalar).v)
+	if b == nil {
+		return p.baseMul(k)
+	}
+	return p.mul(k, b.(*p256Point))
+}
+
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, using Straus's method in constant time.
+func (p *p256Point) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("nist: mismatched number of scalars and points")
+	}
+	k := make([][]byte, len(scalars))
+	b := make([]*p256Point, len(points))
+	for i := range scalars {
+		k[i] = p256Order.Bytes(&scalars[i].(*p256Scalar).v)
+		if points[i] == nil {
+			b[i] = new(p256Point)
+			b[i].Base()
+		} else {
+			b[i] = points[i].(*p256Point)
+		}
+	}
+	return p.multiMul(k, b)
+}
+
+// mul sets p to k times b, where k is a 32-byte big-endian integer.
+func (p *p256Point) mul(k []byte, b *p256Point) kyber.Point {
+	return p.multiMul([][]byte{k}, []*p256Point{b})
+}
+
+// multiMul sets p to the sum of the k[i] times b[i], where the k[i] are
+// 32-byte big-endian integers. The doublings are shared by all the points,
+// which are each added with a fixed window of 4 bits, so that the running
+// time only depends on the number of points.
+func (p *p256Point) multiMul(k [][]byte, b []*p256Point) kyber.Point {
+	tables := make([][16]p256Point, len(b))
+	for j, q := range b {
+		tables[j][0].Null()
+		tables[j][1] = *q
+		for i := 2; i < len(tables[j]); i++ {
+			tables[j][i].add(&tables[j][i-1], q)
+		}
+	}
+
+	var acc, t p256Point
+	acc.Null()
+	for n := 0; n < p256CoordLen; n++ {
+		for _, shift := range [2]uint{4, 0} {
+			for i := 0; i < 4; i++ {
+				acc.add(&acc, &acc)
+			}
+			for j := range tables {
+				w := uint64(k[j][n]>>shift) & 0xf
+				for i := range tables[j] {
+					t.selectPoint(&tables[j][i], montgomery.IsZeroWord(uint64(i)^w))
+				}
+				acc.add(&acc, &t)
+			}
+		}
+	}
+	*p = acc
+	return p
+}
+
+// selectPoint sets p to a if c is 1, and leaves it unchanged if c is 0, in
+// constant time.
+func (p *p256Point) selectPoint(a *p256Point, c uint64) {
+	montgomery.CMove(&p.x, &a.x, c)
+	montgomery.CMove(&p.y, &a.y, c)
+	montgomery.CMove(&p.z, &a.z, c)
+}
+
+// Valid reports whether p is on the curve, whose group of points has
+// prime order.
+func (p *p256Point) Valid() bool {
+	if p.IsSmallOrder() {
+		return true
+	}
+	var x, y, yy, rhs montgomery.Element
+	p.affine(&x, &y)
+	p256Field.Square(&yy, &y)
+	p256RHS(&rhs, &x)
+	return montgomery.Equal(&yy, &rhs) == 1
+}
+
+// IsSmallOrder reports whether p is the point at infinity, the only point
+// of small order of P-256.
+func (p *p256Point) IsSmallOrder() bool {
+	return p.Equal(new(p256Point).Null())
+}
+
+// ClearCofactor sets p to q, since P-256 has prime order.
+func (p *p256Point) ClearCofactor(q kyber.Point) kyber.Point {
+	return p.Set(q)
+}
+
+// baseMul sets p to k times the base point with crypto/ecdh, which derives
+// public keys from private keys in constant time.
+func (p *p256Point) baseMul(k []byte) kyber.Point {
+	priv, err := ecdh.P256().NewPrivateKey(k)
+	if err != nil {
+		// The only scalar that crypto/ecdh rejects is zero.
+		return p.Null()
+	}
+	if err := p.UnmarshalBinary(priv.PublicKey().Bytes()); err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (p *p256Point) MarshalSize() int {
+	return 1 + 2*p256CoordLen // uncompressed ANSI X9.62 representation
+}
+
+func (p *p256Point) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, p.MarshalSize())
+	buf[0] = 4 // uncompressed point
+	if montgomery.IsZero(&p.z) == 1 {
+		// The point at infinity is encoded with zero coordinates.
+		return buf, nil
+	}
+	var x, y montgomery.Element
+	p.affine(&x, &y)
+	copy(buf[1:], p256Field.Bytes(&x))
+	copy(buf[1+p256CoordLen:], p256Field.Bytes(&y))
+	return buf, nil
+}
+
+func (p *p256Point) UnmarshalBinary(buf []byte) error {
+	if len(buf) != p.MarshalSize() {
+		return errors.New("invalid elliptic curve point")
+	}
+	// Check whether all bytes after first one are 0, so we
+	// just return the initial point. Read everything to
+	// prevent timing-leakage.
+	var c byte = 0
+	for _, b := range buf[1:] {
+		c |= b
+	}
+	if c == 0 {
+		p.Null()
+		return nil
+	}
+
+	var x, y, rhs, y2 montgomery.Element
+	if buf[0] != 4 ||
+		!p256Field.SetBytes(&x, buf[1:1+p256CoordLen]) ||
+		!p256Field.SetBytes(&y, buf[1+p256CoordLen:]) {
+		return errors.New("invalid elliptic curve point")
+	}
+	p256RHS(&rhs, &x)
+	p256Field.Square(&y2, &y)
+	if montgomery.Equal(&rhs, &y2) != 1 {
+		return errors.New("invalid elliptic curve point")
+	}
+	p.x, p.y, p.z = x, y, p256Field.One()
+	return nil
+}
+
+func (p *p256Point) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(p, w)
+}
+
+func (p *p256Point) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(p, r)
+}
+
+// affine sets x and y to the affine coordinates of p, which must not be the
+// point at infinity.
+func (p *p256Point) affine(x, y *montgomery.Element) {
+	var zInv montgomery.Element
+	p256Field.Inv(&zInv, &p.z)
+	p256Field.Mul(x, &p.x, &zInv)
+	p256Field.Mul(y, &p.y, &zInv)
+}
+
+// affineInts returns the affine coordinates of p as integers, which are
+// (0,0) for the point at infinity as in Go's elliptic package.
+func (p *p256Point) affineInts() (x, y *big.Int) {
+	buf, _ := p.MarshalBinary()
+	x = new(big.Int).SetBytes(buf[1 : 1+p256CoordLen])
+	y = new(big.Int).SetBytes(buf[1+p256CoordLen:])
+	return x, y
+}
+
+// add sets p = a + b with algorithm 4 of Renes, Costello and Batina.
+func (p *p256Point) add(a, b *p256Point) {
+	f := p256Field
+	var t0, t1, t2, t3, t4, x3, y3, z3 montgomery.Element
+	f.Mul(&t0, &a.x, &b.x)
+	f.Mul(&t1, &a.y, &b.y)
+	f.Mul(&t2, &a.z, &b.z)
+	f.Add(&t3, &a.x, &a.y)
+	f.Add(&t4, &b.x, &b.y)
+	f.Mul(&t3, &t3, &t4)
+	f.Add(&t4, &t0, &t1)
+	f.Sub(&t3, &t3, &t4)
+	f.Add(&t4, &a.y, &a.z)
+	f.Add(&x3, &b.y, &b.z)
+	f.Mul(&t4, &t4, &x3)
+	f.Add(&x3, &t1, &t2)
+	f.Sub(&t4, &t4, &x3)
+	f.Add(&x3, &a.x, &a.z)
+	f.Add(&y3, &b.x, &b.z)
+	f.Mul(&x3, &x3, &y3)
+	f.Add(&y3, &t0, &t2)
+	f.Sub(&y3, &x3, &y3)
+	f.Mul(&z3, &p256B, &t2)
+	f.Sub(&x3, &y3, &z3)
+	f.Add(&z3, &x3, &x3)
+	f.Add(&x3, &x3, &z3)
+	f.Sub(&z3, &t1, &x3)
+	f.Add(&x3, &t1, &x3)
+	f.Mul(&y3, &p256B, &y3)
+	f.Add(&t1, &t2, &t2)
+	f.Add(&t2, &t1, &t2)
+	f.Sub(&y3, &y3, &t2)
+	f.Sub(&y3, &y3, &t0)
+	f.Add(&t1, &y3, &y3)
+	f.Add(&y3, &t1, &y3)
+	f.Add(&t1, &t0, &t0)
+	f.Add(&t0, &t1, &t0)
+	f.Sub(&t0, &t0, &t2)
+	f.Mul(&t1, &t4, &y3)
+	f.Mul(&t2, &t0, &y3)
+	f.Mul(&y3, &x3, &z3)
+	f.Add(&y3, &y3, &t2)
+	f.Mul(&x3, &t3, &x3)
+	f.Sub(&x3, &x3, &t1)
+	f.Mul(&z3, &t4, &z3)
+	f.Mul(&t1, &t3, &t0)
+	f.Add(&z3, &z3, &t1)
+	p.x, p.y, p.z = x3, y3, z3
+}
+
+// p256RHS sets r = x³ - 3x + b.
+func p256RHS(r, x *montgomery.Element) {
+	var t montgomery.Element
+	p256Field.Square(&t, x)
+	p256Field.Mul(&t, &t, x)
+	p256Field.Sub(&t, &t, x)
+	p256Field.Sub(&t, &t, x)
+	p256Field.Sub(&t, &t, x)
+	p256Field.Add(r, &t, &p256B)
+}
+
+// p256Y sets y to a square root of x³ - 3x + b and returns true, or returns
+// false if there is none. Since p = 3 mod 4, the square root of a square a
+// is a^((p+1)/4).
+func p256Y(y, x *montgomery.Element) bool {
+	var a, r, check montgomery.Element
+	p256RHS(&a, x)
+	p256Field.Exp(&r, &a, p256SqrtExp)
+	p256Field.Square(&check, &r)
+	if montgomery.Equal(&check, &a) != 1 {
+		return false
+	}
+	*y = r
+	return true
 }
@@ -0,0 +1,186 @@
+package nist
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
+)
+
+// p256Scalar is an integer modulo the order N of P-256, kept in Montgomery
+// form so that its arithmetic runs in constant time. It is encoded as a
+// 32-byte big-endian integer, as the scalars of the other NIST curves.
+type p256Scalar struct {
+	v montgomery.Element
+}
+
+// Equality test for two Scalars derived from the same Group
+func (s *p256Scalar) Equal(s2 kyber.Scalar) bool {
+	return montgomery.Equal(&s.v, &s2.(*p256Scalar).v) == 1
+}
+
+// Set equal to another Scalar a
+func (s *p256Scalar) Set(a kyber.Scalar) kyber.Scalar {
+	s.v = a.(*p256Scalar).v
+	return s
+}
+
+// Clone returns a duplicate of the scalar s.
+func (s *p256Scalar) Clone() kyber.Scalar {
+	s2 := *s
+	return &s2
+}
+
+// SetInt64 sets the scalar to a small integer value.
+func (s *p256Scalar) SetInt64(v int64) kyber.Scalar {
+	p256Order.SetBig(&s.v, big.NewInt(v))
+	return s
+}
+
+// Set to the additive identity (0)
+func (s *p256Scalar) Zero() kyber.Scalar {
+	s.v = montgomery.Element{}
+	return s
+}
+
+// Set to the multiplicative identity (1)
+func (s *p256Scalar) One() kyber.Scalar {
+	s.v = p256Order.One()
+	return s
+}
+
+// Set to the modular sum of scalars a and b
+func (s *p256Scalar) Add(a, b kyber.Scalar) kyber.Scalar {
+	p256Order.Add(&s.v, &a.(*p256Scalar).v, &b.(*p256Scalar).v)
+	return s
+}
+
+// Set to the modular difference a - b
+func (s *p256Scalar) Sub(a, b kyber.Scalar) kyber.Scalar {
+	p256Order.Sub(&s.v, &a.(*p256Scalar).v, &b.(*p256Scalar).v)
+	return s
+}
+
+// Set to the modular negation of scalar a
+func (s *p256Scalar) Neg(a kyber.Scalar) kyber.Scalar {
+	p256Order.Neg(&s.v, &a.(*p256Scalar).v)
+	return s
+}
+
+// Set to the modular product of scalars a and b
+func (s *p256Scalar) Mul(a, b kyber.Scalar) kyber.Scalar {
+	p256Order.Mul(&s.v, &a.(*p256Scalar).v, &b.(*p256Scalar).v)
+	return s
+}
+
+// Set to the modular division of scalar a by scalar b
+func (s *p256Scalar) Div(a, b kyber.Scalar) kyber.Scalar {
+	var i montgomery.Element
+	p256Order.Inv(&i, &b.(*p256Scalar).v)
+	p256Order.Mul(&s.v, &a.(*p256Scalar).v, &i)
+	return s
+}
+
+// Set to the modular inverse of scalar a
+func (s *p256Scalar) Inv(a kyber.Scalar) kyber.Scalar {
+	p256Order.Inv(&s.v, &a.(*p256Scalar).v)
+	return s
+}
+
+// Set to a fresh random or pseudo-random scalar
+func (s *p256Scalar) Pick(rand cipher.Stream) kyber.Scalar {
+	p256Order.SetBig(&s.v, random.Int(p256Params.N, rand))
+	return s
+}
+
+// SetBytes sets s to b, interpreted as a big endian integer and reduced
+// modulo N.
+func (s *p256Scalar) SetBytes(b []byte) kyber.Scalar {
+	p256Order.SetBig(&s.v, new(big.Int).SetBytes(b))
+	return s
+}
+
+// SetBytesWide sets s to b, interpreted as a big endian integer of any
+// length and reduced modulo N, such as a 64-byte hash.
+func (s *p256Scalar) SetBytesWide(b []byte) kyber.Scalar {
+	return s.SetBytes(b)
+}
+
+// Exp sets s to a^e, in time that only depends on the public exponent e.
+// Negative exponents raise 1/a.
+func (s *p256Scalar) Exp(a kyber.Scalar, e *big.Int) kyber.Scalar {
+	x := a.(*p256Scalar).v
+	if e.Sign() < 0 {
+		p256Order.Inv(&x, &x)
+		e = new(big.Int).Neg(e)
+	}
+	p256Order.Exp(&s.v, &x, e)
+	return s
+}
+
+// Sqrt sets s to a square root of a and returns true if a is a square, or
+// returns false and leaves s unchanged otherwise. It runs in variable time.
+func (s *p256Scalar) Sqrt(a kyber.Scalar) bool {
+	r := new(big.Int).ModSqrt(a.(*p256Scalar).big(), p256Params.N)
+	if r == nil {
+		return false
+	}
+	p256Order.SetBig(&s.v, r)
+	return true
+}
+
+// Legendre returns the Legendre symbol of s modulo N. It runs in variable
+// time.
+func (s *p256Scalar) Legendre() int {
+	return big.Jacobi(s.big(), p256Params.N)
+}
+
+func (s *p256Scalar) big() *big.Int {
+	return new(big.Int).SetBytes(p256Order.Bytes(&s.v))
+}
+
+// String returns the hexadecimal encoding of the scalar without leading
+// zeros, as for mod.Int.
+func (s *p256Scalar) String() string {
+	return hex.EncodeToString(s.big().Bytes())
+}
+
+// Encoded length of this object in bytes.
+func (s *p256Scalar) MarshalSize() int {
+	return p256CoordLen
+}
+
+// MarshalBinary returns the 32-byte big-endian encoding of the scalar.
+func (s *p256Scalar) MarshalBinary() ([]byte, error) {
+	return p256Order.Bytes(&s.v), nil
+}
+
+// UnmarshalBinary reads the 32-byte big-endian encoding of a scalar, which
+// must be reduced modulo N.
+func (s *p256Scalar) UnmarshalBinary(buf []byte) error {
+	if len(buf) != s.MarshalSize() {
+		return errors.New("UnmarshalBinary: wrong size buffer")
+	}
+	if !p256Order.SetBytes(&s.v, buf) {
+		return errors.New("UnmarshalBinary: value out of range")
+	}
+	return nil
+}
+
+// MarshalTo writes the binary representation of this scalar to the given
+// writer.
+func (s *p256Scalar) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.ScalarMarshalTo(s, w)
+}
+
+// UnmarshalFrom reads the binary representation of a scalar from the given
+// reader.
+func (s *p256Scalar) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.ScalarUnmarshalFrom(s, r)
+}
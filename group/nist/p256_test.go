@@ -0,0 +1,93 @@
+package nist
+
+import (
+	"crypto/elliptic"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+var testP256 = NewBlakeSHA256P256()
+
+func TestP256(t *testing.T) { test.SuiteTest(testP256) }
+
+// The constant-time implementation matches the one of Go's elliptic package.
+func TestP256Elliptic(t *testing.T) {
+	c := elliptic.P256()
+	rand := random.New()
+	for i := 0; i < 10; i++ {
+		s := testP256.Scalar().Pick(rand)
+		k, err := s.MarshalBinary()
+		require.NoError(t, err)
+
+		x, y := c.ScalarBaseMult(k)
+		p := testP256.Point().Mul(s, nil)
+		require.Equal(t, elliptic.Marshal(c, x, y), mustMarshal(t, p))
+
+		q := testP256.Point().Pick(rand)
+		qb := mustMarshal(t, q)
+		qx, qy := elliptic.Unmarshal(c, qb)
+		require.NotNil(t, qx)
+		x, y = c.ScalarMult(qx, qy, k)
+		p.Mul(s, q)
+		require.Equal(t, elliptic.Marshal(c, x, y), mustMarshal(t, p))
+
+		x, y = c.Add(x, y, qx, qy)
+		p.Add(p, q)
+		require.Equal(t, elliptic.Marshal(c, x, y), mustMarshal(t, p))
+	}
+
+	// Doubling and adding the point at infinity are not special cases.
+	p := testP256.Point().Base()
+	q := testP256.Point().Add(p, p)
+	x, y := c.Double(c.Params().Gx, c.Params().Gy)
+	require.Equal(t, elliptic.Marshal(c, x, y), mustMarshal(t, q))
+	q.Add(p, testP256.Point().Null())
+	require.True(t, q.Equal(p))
+	q.Sub(p, p)
+	require.True(t, q.Equal(testP256.Point().Null()))
+	require.True(t, q.Mul(testP256.Scalar().Zero(), nil).Equal(testP256.Point().Null()))
+	require.True(t, q.Mul(testP256.Scalar().Zero(), p).Equal(testP256.Point().Null()))
+	minusOne := testP256.Scalar().SetInt64(-1)
+	require.True(t, q.Mul(minusOne, p).Equal(testP256.Point().Neg(p)))
+
+	// Points off the curve are rejected.
+	b := mustMarshal(t, p)
+	b[len(b)-1] ^= 1
+	require.Error(t, q.UnmarshalBinary(b))
+}
+
+// MultiMul matches the sum of the products computed by Mul, including for
+// the base point and the scalars 0 and -1.
+func TestP256MultiMul(t *testing.T) {
+	rand := random.New()
+	scalars := []kyber.Scalar{
+		testP256.Scalar().Pick(rand),
+		testP256.Scalar().Zero(),
+		testP256.Scalar().SetInt64(-1),
+		testP256.Scalar().Pick(rand),
+	}
+	points := []kyber.Point{
+		testP256.Point().Pick(rand),
+		testP256.Point().Pick(rand),
+		testP256.Point().Pick(rand),
+		nil,
+	}
+	sum := testP256.Point().Null()
+	for i := range scalars {
+		sum.Add(sum, testP256.Point().Mul(scalars[i], points[i]))
+	}
+	p := testP256.Point().(kyber.MultiScalarMul).MultiMul(scalars, points)
+	require.True(t, p.Equal(sum))
+	p = testP256.Point().(kyber.MultiScalarMul).MultiMul(nil, nil)
+	require.True(t, p.Equal(testP256.Point().Null()))
+}
+
+func mustMarshal(t *testing.T, p interface{ MarshalBinary() ([]byte, error) }) []byte {
+	b, err := p.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,37 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// P384 implements the kyber.Group interface
+// for the NIST P-384 elliptic curve,
+// based on Go's native elliptic curve library.
+type p384 struct {
+	curve
+	sqrtExp *big.Int
+}
+
+func (curve *p384) String() string {
+	return "P384"
+}
+
+// Modular square root for P-384 curve: since p = 3 mod 4,
+// the square root of a quadratic residue c is c^((p+1)/4).
+func (curve *p384) sqrt(c *big.Int) *big.Int {
+	return new(big.Int).Exp(c, curve.sqrtExp, curve.p.P)
+}
+
+// Initialize standard Curve instances
+func (c *p384) Init() curve {
+	c.curve.Curve = elliptic.P384()
+	c.p = c.Params()
+	c.curveOps = c
+	c.z = big.NewInt(-12)
+	c.sqrtExp = new(big.Int).Add(c.p.P, big.NewInt(1))
+	c.sqrtExp.Rsh(c.sqrtExp, 2)
+	return c.curve
+}
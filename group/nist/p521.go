@@ -0,0 +1,41 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// P521 implements the kyber.Group interface
+// for the NIST P-521 elliptic curve,
+// based on Go's native elliptic curve library.
+type p521 struct {
+	curve
+}
+
+func (curve *p521) String() string {
+	return "P521"
+}
+
+// Modular square root for P-521 curve: since p = 2^521-1,
+// the square root of a quadratic residue c is c^((p+1)/4) = c^(2^519),
+// that is c squared 519 times.
+func (curve *p521) sqrt(c *big.Int) *big.Int {
+	m := curve.p.P
+	r := new(big.Int).Set(c)
+	for i := 0; i < 519; i++ {
+		r.Mul(r, r)
+		r.Mod(r, m)
+	}
+	return r
+}
+
+// Initialize standard Curve instances
+func (c *p521) Init() curve {
+	c.curve.Curve = elliptic.P521()
+	c.p = c.Params()
+	c.curveOps = c
+	c.z = big.NewInt(-4)
+	return c.curve
+}
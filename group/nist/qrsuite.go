@@ -63,3 +63,25 @@ func NewBlakeSHA256QR512() *QrSuite {
 	suite.SetParams(p, q, r, g)
 	return suite
 }
+
+// NewBlakeSHA256MODP returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the RFC 3526 MODP group
+// of the given size in bits returned by MODP.
+func NewBlakeSHA256MODP(bits int) (*QrSuite, error) {
+	g, err := MODP(bits)
+	if err != nil {
+		return nil, err
+	}
+	return &QrSuite{*g}, nil
+}
+
+// NewBlakeSHA256FFDHE returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the RFC 7919 ffdhe group
+// of the given size in bits returned by FFDHE.
+func NewBlakeSHA256FFDHE(bits int) (*QrSuite, error) {
+	g, err := FFDHE(bits)
+	if err != nil {
+		return nil, err
+	}
+	return &QrSuite{*g}, nil
+}
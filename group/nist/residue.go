@@ -14,6 +14,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/msm"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -64,6 +65,18 @@ func (p *residuePoint) Valid() bool {
 		new(big.Int).Exp(&p.Int, p.g.Q, p.g.P).Cmp(one) == 0
 }
 
+// IsSmallOrder reports whether p is the identity element,
+// or more generally whether p^R is 1.
+func (p *residuePoint) IsSmallOrder() bool {
+	return new(big.Int).Exp(&p.Int, p.g.R, p.g.P).Cmp(one) == 0
+}
+
+// ClearCofactor sets p to q^R, which is in the subgroup of order Q.
+func (p *residuePoint) ClearCofactor(q kyber.Point) kyber.Point {
+	p.Int.Exp(&q.(*residuePoint).Int, p.g.R, p.g.P)
+	return p
+}
+
 func (p *residuePoint) EmbedLen() int {
 	// Reserve at least 8 most-significant bits for randomness,
 	// and the least-significant 16 bits for embedded data length.
@@ -141,6 +154,16 @@ func (p *residuePoint) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
 	return p
 }
 
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point.
+func (p *residuePoint) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	v := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		v[i] = &s.(*mod.Int).V
+	}
+	return msm.MultiMulInt(p, v, points)
+}
+
 func (p *residuePoint) MarshalSize() int {
 	return (p.g.P.BitLen() + 7) / 8
 }
@@ -197,9 +220,16 @@ ONLY on quadratic residue groups in which R=2.
 type ResidueGroup struct {
 	dsa.Parameters
 	R *big.Int
+
+	name string
 }
 
+// String returns the name of a standard group, such as "ffdhe2048", or
+// "Residue" followed by the size of P in bits for other groups.
 func (g *ResidueGroup) String() string {
+	if g.name != "" {
+		return g.name
+	}
 	return fmt.Sprintf("Residue%d", g.P.BitLen())
 }
 
@@ -208,7 +238,7 @@ func (g *ResidueGroup) String() string {
 func (g *ResidueGroup) ScalarLen() int { return (g.Q.BitLen() + 7) / 8 }
 
 // Create a Scalar associated with this Residue group,
-// with an initial value of nil.
+// with an initial value of nil. Scalars are mod.Ints.
 func (g *ResidueGroup) Scalar() kyber.Scalar {
 	return mod.NewInt64(0, g.Q)
 }
@@ -230,6 +260,17 @@ func (g *ResidueGroup) Order() *big.Int {
 	return g.Q
 }
 
+// Return the cofactor R of the subgroup of order Q.
+func (g *ResidueGroup) Cofactor() *big.Int {
+	return g.R
+}
+
+// Return true: Q is prime, and decoding checks that
+// elements belong to the subgroup of order Q.
+func (g *ResidueGroup) IsPrimeOrder() bool {
+	return true
+}
+
 // Validate the parameters for a Residue group,
 // checking that P and Q are prime, P=Q*R+1,
 // and that G is a valid generator for this group.
@@ -262,6 +303,7 @@ func (g *ResidueGroup) SetParams(P, Q, R, G *big.Int) {
 	g.Q = Q
 	g.R = R
 	g.G = G
+	g.name = ""
 	if !g.Valid() {
 		panic("SetParams: bad Residue group parameters")
 	}
@@ -272,6 +314,7 @@ func (g *ResidueGroup) SetParams(P, Q, R, G *big.Int) {
 // and the smallest valid generator G for this group.
 func (g *ResidueGroup) QuadraticResidueGroup(bitlen uint, rand cipher.Stream) {
 	g.R = two
+	g.name = ""
 
 	// pick primes p,q such that p = 2q+1
 	fmt.Printf("Generating %d-bit QR group", bitlen)
@@ -4,7 +4,7 @@ package nist
 
 import (
 	"crypto/cipher"
-	"crypto/sha256"
+	"crypto/sha512"
 	"hash"
 	"io"
 	"reflect"
@@ -14,47 +14,89 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
-	"github.com/dedis/kyber/xof/blake2xb"
+	"github.com/dedis/kyber/xof/keccak"
 )
 
-type Suite128 struct {
-	p256
+type Suite192 struct {
+	p384
 }
 
-// SHA256 hash function
-func (s *Suite128) Hash() hash.Hash {
-	return sha256.New()
+// SHA384 hash function
+func (s *Suite192) Hash() hash.Hash {
+	return sha512.New384()
 }
 
-func (s *Suite128) XOF(key []byte) kyber.XOF {
-	return blake2xb.New(key)
+func (s *Suite192) XOF(key []byte) kyber.XOF {
+	return keccak.New(key)
 }
 
-func (s *Suite128) RandomStream() cipher.Stream {
+func (s *Suite192) RandomStream() cipher.Stream {
 	return random.New()
 }
 
-func (s *Suite128) Read(r io.Reader, objs ...interface{}) error {
+func (s *Suite192) Read(r io.Reader, objs ...interface{}) error {
 	return fixbuf.Read(r, s, objs)
 }
 
-func (s *Suite128) Write(w io.Writer, objs ...interface{}) error {
+func (s *Suite192) Write(w io.Writer, objs ...interface{}) error {
 	return fixbuf.Write(w, objs)
 }
 
-func (s *Suite128) New(t reflect.Type) interface{} {
+func (s *Suite192) New(t reflect.Type) interface{} {
 	return marshalling.GroupNew(s, t)
 }
 
-// NewBlakeSHA256P256 returns a cipher suite based on package
-// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the NIST P-256
+// NewShakeSHA384P384 returns a cipher suite based on SHAKE256 from package
+// github.com/dedis/kyber/xof/keccak, SHA-384, and the NIST P-384
 // elliptic curve. It returns random streams from Go's crypto/rand.
 //
 // The scalars created by this group implement kyber.Scalar's SetBytes
 // method, interpreting the bytes as a big-endian integer, so as to be
 // compatible with the Go standard library's big.Int type.
-func NewBlakeSHA256P256() *Suite128 {
-	suite := new(Suite128)
-	suite.p256.Init()
+func NewShakeSHA384P384() *Suite192 {
+	suite := new(Suite192)
+	suite.p384.Init()
+	return suite
+}
+
+type Suite256 struct {
+	p521
+}
+
+// SHA512 hash function
+func (s *Suite256) Hash() hash.Hash {
+	return sha512.New()
+}
+
+func (s *Suite256) XOF(key []byte) kyber.XOF {
+	return keccak.New(key)
+}
+
+func (s *Suite256) RandomStream() cipher.Stream {
+	return random.New()
+}
+
+func (s *Suite256) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs)
+}
+
+func (s *Suite256) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *Suite256) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// NewShakeSHA512P521 returns a cipher suite based on SHAKE256 from package
+// github.com/dedis/kyber/xof/keccak, SHA-512, and the NIST P-521
+// elliptic curve. It returns random streams from Go's crypto/rand.
+//
+// The scalars created by this group implement kyber.Scalar's SetBytes
+// method, interpreting the bytes as a big-endian integer, so as to be
+// compatible with the Go standard library's big.Int type.
+func NewShakeSHA512P521() *Suite256 {
+	suite := new(Suite256)
+	suite.p521.Init()
 	return suite
 }
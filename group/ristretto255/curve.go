@@ -0,0 +1,78 @@
+// Package ristretto255 provides the ristretto255 prime-order group,
+// as specified in RFC 9496 (https://ristretto.group).
+//
+// Ristretto255 is built on top of the Ed25519 curve, but eliminates its
+// cofactor: every element has a unique 32-byte canonical encoding, and
+// the group has prime order 2^252 + 27742317777372353535851937790883648493.
+// Protocols designed for prime-order groups can therefore be implemented
+// on it without the cofactor-related pitfalls of plain Ed25519.
+//
+// The field and group arithmetic is shared with package
+// github.com/dedis/kyber/group/edwards25519, and so are the scalars.
+// All operations are constant time.
+package ristretto255
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+)
+
+// Curve represents the ristretto255 group.
+// There are no parameters and no initialization is required
+// because it supports only this one specific group.
+type Curve struct {
+	ed edwards25519.Curve
+}
+
+// Return the name of the group, "Ristretto255".
+func (c *Curve) String() string {
+	return "Ristretto255"
+}
+
+// ScalarLen returns 32, the size in bytes of an encoded Scalar.
+func (c *Curve) ScalarLen() int {
+	return 32
+}
+
+// Scalar creates a new Scalar modulo the order of the ristretto255 group.
+// Scalars are encoded as 32-byte little-endian integers, as for Ed25519.
+func (c *Curve) Scalar() kyber.Scalar {
+	return c.ed.Scalar()
+}
+
+// PointLen returns 32, the size in bytes of an encoded Point.
+func (c *Curve) PointLen() int {
+	return 32
+}
+
+// Point creates a new Point of the ristretto255 group,
+// initialized to the identity element.
+func (c *Curve) Point() kyber.Point {
+	return edwards25519.NewRistrettoPoint()
+}
+
+// NewKey returns a uniformly random secret scalar. Since ristretto255
+// has prime order, no clamping is needed.
+// NewKey implements the kyber/util/key.Generator interface.
+func (c *Curve) NewKey(stream cipher.Stream) kyber.Scalar {
+	return c.Scalar().Pick(stream)
+}
+
+// Order returns the prime order of the group,
+// 2^252 + 27742317777372353535851937790883648493.
+func (c *Curve) Order() *big.Int {
+	return c.ed.Order()
+}
+
+// Cofactor returns 1, since ristretto255 has prime order.
+func (c *Curve) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+// IsPrimeOrder returns true.
+func (c *Curve) IsPrimeOrder() bool {
+	return true
+}
@@ -0,0 +1,71 @@
+package ristretto255
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake2xb"
+)
+
+// SuiteRistretto255 implements some basic functionalities such as Group,
+// HashFactory, and XOFFactory.
+type SuiteRistretto255 struct {
+	Curve
+	r cipher.Stream
+}
+
+// Hash returns a newly instanciated sha256 hash function.
+func (s *SuiteRistretto255) Hash() hash.Hash {
+	return sha256.New()
+}
+
+// XOF returns an XOF which is implemented via the Blake2b hash.
+func (s *SuiteRistretto255) XOF(key []byte) kyber.XOF {
+	return blake2xb.New(key)
+}
+
+func (s *SuiteRistretto255) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteRistretto255) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+// New implements the kyber.Encoding interface
+func (s *SuiteRistretto255) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *SuiteRistretto255) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+// NewBlakeSHA256Ristretto255 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the ristretto255 group.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewBlakeSHA256Ristretto255() *SuiteRistretto255 {
+	suite := new(SuiteRistretto255)
+	return suite
+}
+
+// NewBlakeSHA256Ristretto255WithRand returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the ristretto255 group.
+// It produces cryptographically random numbers via the provided stream r.
+func NewBlakeSHA256Ristretto255WithRand(r cipher.Stream) *SuiteRistretto255 {
+	suite := new(SuiteRistretto255)
+	suite.r = r
+	return suite
+}
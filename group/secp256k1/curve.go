@@ -0,0 +1,111 @@
+// Package secp256k1 provides the secp256k1 elliptic curve of SEC 2, which
+// Bitcoin and Ethereum use for their keys and signatures.
+//
+// The curve y² = x³ + 7 over GF(p), with p = 2^256 - 2^32 - 977, has prime
+// order n, so that every point other than the point at infinity generates
+// the whole group. Points are encoded in the 33-byte compressed form of
+// SEC 1 and scalars as 32-byte big-endian integers, which are the formats
+// of Bitcoin public and private keys. All operations on points and scalars
+// are constant time, except for decoding, which only handles public values.
+package secp256k1
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/montgomery"
+)
+
+var (
+	// p is the order of the field of coordinates.
+	p, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+	// n is the order of the group.
+	n, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+
+	baseField   = montgomery.NewField(p)
+	scalarField = montgomery.NewField(n)
+
+	pPlus1Over4 = new(big.Int).Rsh(new(big.Int).Add(p, big.NewInt(1)), 2)
+
+	// curveB is the constant 7 of the curve equation, and curveB3 is 3·7,
+	// which is 0x15 in the hexadecimal notation of fieldElement.
+	curveB  = fieldElement("7")
+	curveB3 = fieldElement("15")
+
+	basePoint = point{
+		x: fieldElement("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		y: fieldElement("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+		z: baseField.One(),
+	}
+)
+
+// fieldElement returns the Montgomery form of the hexadecimal integer h. The
+// constants are set in variable declarations rather than in init, so that
+// points can be used while initializing other package-level variables.
+func fieldElement(h string) montgomery.Element {
+	n, _ := new(big.Int).SetString(h, 16)
+	var e montgomery.Element
+	baseField.SetBig(&e, n)
+	return e
+}
+
+// Curve represents the secp256k1 group.
+// There are no parameters and no initialization is required
+// because it supports only this one specific curve.
+type Curve struct {
+}
+
+// Return the name of the curve, "secp256k1".
+func (c *Curve) String() string {
+	return "secp256k1"
+}
+
+// ScalarLen returns 32, the size in bytes of an encoded Scalar.
+func (c *Curve) ScalarLen() int {
+	return 32
+}
+
+// Scalar creates a new Scalar modulo the order of the group.
+func (c *Curve) Scalar() kyber.Scalar {
+	return &scalar{}
+}
+
+// PointLen returns 33, the size in bytes of a compressed Point.
+func (c *Curve) PointLen() int {
+	return pointLen
+}
+
+// Point creates a new Point on the curve, initialized to the point at
+// infinity.
+func (c *Curve) Point() kyber.Point {
+	P := &point{}
+	P.Null()
+	return P
+}
+
+// NewKey returns a uniformly random nonzero secret scalar, as required for
+// Bitcoin and Ethereum private keys.
+// NewKey implements the kyber/util/key.Generator interface.
+func (c *Curve) NewKey(stream cipher.Stream) kyber.Scalar {
+	s := c.Scalar()
+	zero := c.Scalar().Zero()
+	for s.Pick(stream).Equal(zero) {
+	}
+	return s
+}
+
+// Order returns the prime order n of the group.
+func (c *Curve) Order() *big.Int {
+	return new(big.Int).Set(n)
+}
+
+// Cofactor returns 1, since secp256k1 has prime order.
+func (c *Curve) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+// IsPrimeOrder returns true.
+func (c *Curve) IsPrimeOrder() bool {
+	return true
+}
@@ -0,0 +1,92 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+var tSuite = NewBlakeSHA256Secp256k1()
+var groupBench = test.NewGroupBench(tSuite)
+
+func TestSuite(t *testing.T) { test.SuiteTest(tSuite) }
+
+// Public keys of the private keys 1, 2, 3 and n-1.
+func TestVectors(t *testing.T) {
+	vectors := []struct {
+		k, pub string
+	}{
+		{
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+		},
+		{
+			"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140",
+			"0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		},
+	}
+	for _, v := range vectors {
+		kb, _ := hex.DecodeString(v.k)
+		k := tSuite.Scalar()
+		require.NoError(t, k.UnmarshalBinary(kb))
+		P := tSuite.Point().Mul(k, nil)
+		require.Equal(t, v.pub, P.String())
+
+		Q := tSuite.Point()
+		pb, _ := hex.DecodeString(v.pub)
+		require.NoError(t, Q.UnmarshalBinary(pb))
+		require.True(t, P.Equal(Q))
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	G, _ := tSuite.Point().Base().MarshalBinary()
+	P := tSuite.Point()
+
+	bad := append([]byte{}, G...)
+	bad[0] = 0x04
+	require.Error(t, P.UnmarshalBinary(bad))
+	require.Error(t, P.UnmarshalBinary(G[1:]))
+
+	// x = p is not reduced.
+	pb, _ := hex.DecodeString("02fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	require.Error(t, P.UnmarshalBinary(pb))
+
+	// x = 5 is not on the curve, since 132 is not a square modulo p.
+	bad = make([]byte, 33)
+	bad[0], bad[32] = 0x02, 5
+	require.Error(t, P.UnmarshalBinary(bad))
+
+	// Scalars must be reduced modulo n.
+	nb, _ := hex.DecodeString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	require.Error(t, tSuite.Scalar().UnmarshalBinary(nb))
+}
+
+func BenchmarkScalarAdd(b *testing.B)    { groupBench.ScalarAdd(b.N) }
+func BenchmarkScalarSub(b *testing.B)    { groupBench.ScalarSub(b.N) }
+func BenchmarkScalarNeg(b *testing.B)    { groupBench.ScalarNeg(b.N) }
+func BenchmarkScalarMul(b *testing.B)    { groupBench.ScalarMul(b.N) }
+func BenchmarkScalarDiv(b *testing.B)    { groupBench.ScalarDiv(b.N) }
+func BenchmarkScalarInv(b *testing.B)    { groupBench.ScalarInv(b.N) }
+func BenchmarkScalarPick(b *testing.B)   { groupBench.ScalarPick(b.N) }
+func BenchmarkScalarEncode(b *testing.B) { groupBench.ScalarEncode(b.N) }
+func BenchmarkScalarDecode(b *testing.B) { groupBench.ScalarDecode(b.N) }
+
+func BenchmarkPointAdd(b *testing.B)     { groupBench.PointAdd(b.N) }
+func BenchmarkPointSub(b *testing.B)     { groupBench.PointSub(b.N) }
+func BenchmarkPointNeg(b *testing.B)     { groupBench.PointNeg(b.N) }
+func BenchmarkPointMul(b *testing.B)     { groupBench.PointMul(b.N) }
+func BenchmarkPointBaseMul(b *testing.B) { groupBench.PointBaseMul(b.N) }
+func BenchmarkPointPick(b *testing.B)    { groupBench.PointPick(b.N) }
+func BenchmarkPointEncode(b *testing.B)  { groupBench.PointEncode(b.N) }
+func BenchmarkPointDecode(b *testing.B)  { groupBench.PointDecode(b.N) }
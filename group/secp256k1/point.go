@@ -0,0 +1,373 @@
+package secp256k1
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
+)
+
+// point is a point of the curve y² = x³ + 7 in homogeneous projective
+// coordinates (X:Y:Z), which stand for the affine point (X/Z, Y/Z). The point
+// at infinity is (0:1:0). Additions and doublings use the complete formulas
+// for curves with a = 0 of "Complete addition formulas for prime order
+// elliptic curves" by Renes, Costello and Batina
+// (https://eprint.iacr.org/2015/1060), algorithms 7 and 9, which have no
+// exceptional cases and thus no secret-dependent branches.
+type point struct {
+	x, y, z montgomery.Element
+}
+
+// Points are encoded in the compressed form of SEC 1, as 0x02 or 0x03
+// according to the parity of y, followed by the 32-byte big-endian
+// x-coordinate. The point at infinity, which SEC 1 encodes as a single zero
+// byte, is encoded here as 33 zero bytes so that all encodings have the same
+// length.
+const (
+	pointLen       = 33
+	prefixEven     = 0x02
+	prefixOdd      = 0x03
+	prefixInfinity = 0x00
+)
+
+// Equality test for two Points derived from the same Group
+func (P *point) Equal(P2 kyber.Point) bool {
+	Q := P2.(*point)
+	var a, b, c, d montgomery.Element
+	baseField.Mul(&a, &P.x, &Q.z)
+	baseField.Mul(&b, &Q.x, &P.z)
+	baseField.Mul(&c, &P.y, &Q.z)
+	baseField.Mul(&d, &Q.y, &P.z)
+	return montgomery.Equal(&a, &b)&montgomery.Equal(&c, &d) == 1
+}
+
+// Null sets P to the point at infinity.
+func (P *point) Null() kyber.Point {
+	P.x = montgomery.Element{}
+	P.y = baseField.One()
+	P.z = montgomery.Element{}
+	return P
+}
+
+// Base sets P to the standard base point of secp256k1.
+func (P *point) Base() kyber.Point {
+	*P = basePoint
+	return P
+}
+
+// Pick sets P to a point chosen uniformly at random.
+func (P *point) Pick(rand cipher.Stream) kyber.Point {
+	return P.Embed(nil, rand)
+}
+
+// Set P equal to another point.
+func (P *point) Set(P2 kyber.Point) kyber.Point {
+	*P = *P2.(*point)
+	return P
+}
+
+// Clone returns a duplicate of P.
+func (P *point) Clone() kyber.Point {
+	P2 := *P
+	return &P2
+}
+
+// EmbedLen returns the number of bytes of data that can be embedded in a
+// point: the x-coordinate keeps 8 random bits at the top, to find a point
+// quickly, and 8 bits at the bottom for the length of the data.
+func (P *point) EmbedLen() int {
+	return (256 - 8 - 8) / 8
+}
+
+// Embed sets P to a point whose x-coordinate contains the given data, with
+// the remaining bits chosen at random.
+func (P *point) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	dl := P.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		b := random.Bits(256, false, rand)
+		if data != nil {
+			b[31] = byte(dl)        // Encode length in low 8 bits
+			copy(b[31-dl:31], data) // Copy in data to embed
+		}
+		var x, y montgomery.Element
+		if !baseField.SetBytes(&x, b) || !curveY(&y, &x) {
+			continue
+		}
+		// Pick the sign of y at random.
+		var sign [1]byte
+		rand.XORKeyStream(sign[:], sign[:])
+		var negY montgomery.Element
+		baseField.Neg(&negY, &y)
+		montgomery.CMove(&y, &negY, uint64(sign[0]&1))
+
+		P.x, P.y, P.z = x, y, baseField.One()
+		return P
+	}
+}
+
+// Data extracts the data embedded in P with Embed.
+func (P *point) Data() ([]byte, error) {
+	var x, y montgomery.Element
+	P.affine(&x, &y)
+	b := baseField.Bytes(&x)
+	dl := int(b[31])
+	if dl > P.EmbedLen() {
+		return nil, errors.New("secp256k1: invalid embedded data length")
+	}
+	return b[31-dl : 31], nil
+}
+
+// Add sets P to the sum of P1 and P2.
+func (P *point) Add(P1, P2 kyber.Point) kyber.Point {
+	P.add(P1.(*point), P2.(*point))
+	return P
+}
+
+// Sub sets P to P1 - P2.
+func (P *point) Sub(P1, P2 kyber.Point) kyber.Point {
+	var Q point
+	Q.Neg(P2)
+	P.add(P1.(*point), &Q)
+	return P
+}
+
+// Neg sets P to the negation of A.
+func (P *point) Neg(A kyber.Point) kyber.Point {
+	Q := A.(*point)
+	P.x = Q.x
+	baseField.Neg(&P.y, &Q.y)
+	P.z = Q.z
+	return P
+}
+
+// Mul sets P to s times A, or s times the base point if A is nil, in
+// constant time.
+func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
+	Q := &basePoint
+	if A != nil {
+		Q = A.(*point)
+	}
+
+	// Fixed 4-bit windows over the big-endian encoding of s, with the
+	// multiples of Q selected in constant time.
+	var table [16]point
+	table[0].Null()
+	table[1] = *Q
+	for i := 2; i < 16; i += 2 {
+		table[i].double(&table[i/2])
+		table[i+1].add(&table[i], Q)
+	}
+
+	k := scalarField.Bytes(&s.(*scalar).v)
+	var R, T point
+	R.Null()
+	for _, b := range k {
+		for _, w := range []byte{b >> 4, b & 15} {
+			R.double(&R)
+			R.double(&R)
+			R.double(&R)
+			R.double(&R)
+			T.selectPoint(&table, w)
+			R.add(&R, &T)
+		}
+	}
+	*P = R
+	return P
+}
+
+// Valid returns true: decoding checks that points are on the curve, whose
+// group of points has prime order.
+func (P *point) Valid() bool {
+	return true
+}
+
+// IsSmallOrder reports whether P is the point at infinity, the only point
+// of small order of secp256k1.
+func (P *point) IsSmallOrder() bool {
+	var O point
+	return P.Equal(O.Null())
+}
+
+// ClearCofactor sets P to A, since secp256k1 has prime order.
+func (P *point) ClearCofactor(A kyber.Point) kyber.Point {
+	return P.Set(A)
+}
+
+// String returns the hexadecimal encoding of P.
+func (P *point) String() string {
+	b, _ := P.MarshalBinary()
+	return hex.EncodeToString(b)
+}
+
+// MarshalSize returns 33, the length of the compressed encoding of points.
+func (P *point) MarshalSize() int {
+	return pointLen
+}
+
+// MarshalBinary returns the compressed encoding of P.
+func (P *point) MarshalBinary() ([]byte, error) {
+	b := make([]byte, pointLen)
+	if montgomery.IsZero(&P.z) == 1 {
+		return b, nil
+	}
+	var x, y montgomery.Element
+	P.affine(&x, &y)
+	b[0] = prefixEven | byte(baseField.IsOdd(&y))
+	copy(b[1:], baseField.Bytes(&x))
+	return b, nil
+}
+
+// UnmarshalBinary sets P to the point of the given compressed encoding,
+// after checking that the x-coordinate is reduced and on the curve.
+func (P *point) UnmarshalBinary(b []byte) error {
+	if len(b) != pointLen {
+		return errors.New("secp256k1: wrong size buffer")
+	}
+	switch b[0] {
+	case prefixInfinity:
+		for _, c := range b[1:] {
+			if c != 0 {
+				return errors.New("secp256k1: invalid point encoding")
+			}
+		}
+		P.Null()
+		return nil
+	case prefixEven, prefixOdd:
+	default:
+		return errors.New("secp256k1: invalid point encoding")
+	}
+
+	var x, y montgomery.Element
+	if !baseField.SetBytes(&x, b[1:]) {
+		return errors.New("secp256k1: x-coordinate is not reduced modulo p")
+	}
+	if !curveY(&y, &x) {
+		return errors.New("secp256k1: point is not on the curve")
+	}
+	if baseField.IsOdd(&y) != uint64(b[0]&1) {
+		baseField.Neg(&y, &y)
+	}
+	P.x, P.y, P.z = x, y, baseField.One()
+	return nil
+}
+
+// MarshalTo writes the compressed encoding of P to w.
+func (P *point) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+// UnmarshalFrom reads the compressed encoding of P from r.
+func (P *point) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
+
+// affine sets x and y to the affine coordinates of P, which must not be the
+// point at infinity.
+func (P *point) affine(x, y *montgomery.Element) {
+	var zInv montgomery.Element
+	baseField.Inv(&zInv, &P.z)
+	baseField.Mul(x, &P.x, &zInv)
+	baseField.Mul(y, &P.y, &zInv)
+}
+
+// add sets P = A + B with algorithm 7 of Renes, Costello and Batina.
+func (P *point) add(A, B *point) {
+	f := baseField
+	var t0, t1, t2, t3, t4, x3, y3, z3 montgomery.Element
+	f.Mul(&t0, &A.x, &B.x)
+	f.Mul(&t1, &A.y, &B.y)
+	f.Mul(&t2, &A.z, &B.z)
+	f.Add(&t3, &A.x, &A.y)
+	f.Add(&t4, &B.x, &B.y)
+	f.Mul(&t3, &t3, &t4)
+	f.Add(&t4, &t0, &t1)
+	f.Sub(&t3, &t3, &t4)
+	f.Add(&t4, &A.y, &A.z)
+	f.Add(&x3, &B.y, &B.z)
+	f.Mul(&t4, &t4, &x3)
+	f.Add(&x3, &t1, &t2)
+	f.Sub(&t4, &t4, &x3)
+	f.Add(&x3, &A.x, &A.z)
+	f.Add(&y3, &B.x, &B.z)
+	f.Mul(&x3, &x3, &y3)
+	f.Add(&y3, &t0, &t2)
+	f.Sub(&y3, &x3, &y3)
+	f.Add(&x3, &t0, &t0)
+	f.Add(&t0, &x3, &t0)
+	f.Mul(&t2, &curveB3, &t2)
+	f.Add(&z3, &t1, &t2)
+	f.Sub(&t1, &t1, &t2)
+	f.Mul(&y3, &curveB3, &y3)
+	f.Mul(&x3, &t4, &y3)
+	f.Mul(&t2, &t3, &t1)
+	f.Sub(&x3, &t2, &x3)
+	f.Mul(&y3, &y3, &t0)
+	f.Mul(&t1, &t1, &z3)
+	f.Add(&y3, &t1, &y3)
+	f.Mul(&t0, &t0, &t3)
+	f.Mul(&z3, &z3, &t4)
+	f.Add(&z3, &z3, &t0)
+	P.x, P.y, P.z = x3, y3, z3
+}
+
+// double sets P = 2A with algorithm 9 of Renes, Costello and Batina.
+func (P *point) double(A *point) {
+	f := baseField
+	var t0, t1, t2, x3, y3, z3 montgomery.Element
+	f.Square(&t0, &A.y)
+	f.Add(&z3, &t0, &t0)
+	f.Add(&z3, &z3, &z3)
+	f.Add(&z3, &z3, &z3)
+	f.Mul(&t1, &A.y, &A.z)
+	f.Square(&t2, &A.z)
+	f.Mul(&t2, &curveB3, &t2)
+	f.Mul(&x3, &t2, &z3)
+	f.Add(&y3, &t0, &t2)
+	f.Mul(&z3, &t1, &z3)
+	f.Add(&t1, &t2, &t2)
+	f.Add(&t2, &t1, &t2)
+	f.Sub(&t0, &t0, &t2)
+	f.Mul(&y3, &t0, &y3)
+	f.Add(&y3, &x3, &y3)
+	f.Mul(&t1, &A.x, &A.y)
+	f.Mul(&x3, &t0, &t1)
+	f.Add(&x3, &x3, &x3)
+	P.x, P.y, P.z = x3, y3, z3
+}
+
+// selectPoint sets P to table[i] without leaking i through memory accesses.
+func (P *point) selectPoint(table *[16]point, i byte) {
+	P.Null()
+	for j := range table {
+		c := montgomery.IsZeroWord(uint64(i) ^ uint64(j))
+		montgomery.CMove(&P.x, &table[j].x, c)
+		montgomery.CMove(&P.y, &table[j].y, c)
+		montgomery.CMove(&P.z, &table[j].z, c)
+	}
+}
+
+// curveY sets y to a square root of x³ + 7 and returns true, or returns
+// false if there is none. Since p = 3 mod 4, the square root of a square a
+// is a^((p+1)/4).
+func curveY(y, x *montgomery.Element) bool {
+	var a, r, check montgomery.Element
+	baseField.Square(&a, x)
+	baseField.Mul(&a, &a, x)
+	baseField.Add(&a, &a, &curveB)
+	baseField.Exp(&r, &a, pPlus1Over4)
+	baseField.Square(&check, &r)
+	if montgomery.Equal(&check, &a) != 1 {
+		return false
+	}
+	*y = r
+	return true
+}
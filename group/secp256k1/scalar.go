@@ -0,0 +1,185 @@
+package secp256k1
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/group/internal/montgomery"
+	"github.com/dedis/kyber/util/random"
+)
+
+// scalar is an integer modulo the order n of the base point, encoded as a
+// 32-byte big-endian integer as in Bitcoin and Ethereum private keys.
+type scalar struct {
+	v montgomery.Element
+}
+
+// Equality test for two Scalars derived from the same Group
+func (s *scalar) Equal(s2 kyber.Scalar) bool {
+	return montgomery.Equal(&s.v, &s2.(*scalar).v) == 1
+}
+
+// Set equal to another Scalar a
+func (s *scalar) Set(a kyber.Scalar) kyber.Scalar {
+	s.v = a.(*scalar).v
+	return s
+}
+
+// Clone returns a duplicate of the scalar s.
+func (s *scalar) Clone() kyber.Scalar {
+	s2 := *s
+	return &s2
+}
+
+// SetInt64 sets the scalar to a small integer value.
+func (s *scalar) SetInt64(v int64) kyber.Scalar {
+	scalarField.SetBig(&s.v, big.NewInt(v))
+	return s
+}
+
+// Set to the additive identity (0)
+func (s *scalar) Zero() kyber.Scalar {
+	s.v = montgomery.Element{}
+	return s
+}
+
+// Set to the multiplicative identity (1)
+func (s *scalar) One() kyber.Scalar {
+	s.v = scalarField.One()
+	return s
+}
+
+// Set to the modular sum of scalars a and b
+func (s *scalar) Add(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Add(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular difference a - b
+func (s *scalar) Sub(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Sub(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular negation of scalar a
+func (s *scalar) Neg(a kyber.Scalar) kyber.Scalar {
+	scalarField.Neg(&s.v, &a.(*scalar).v)
+	return s
+}
+
+// Set to the modular product of scalars a and b
+func (s *scalar) Mul(a, b kyber.Scalar) kyber.Scalar {
+	scalarField.Mul(&s.v, &a.(*scalar).v, &b.(*scalar).v)
+	return s
+}
+
+// Set to the modular division of scalar a by scalar b
+func (s *scalar) Div(a, b kyber.Scalar) kyber.Scalar {
+	var i montgomery.Element
+	scalarField.Inv(&i, &b.(*scalar).v)
+	scalarField.Mul(&s.v, &a.(*scalar).v, &i)
+	return s
+}
+
+// Set to the modular inverse of scalar a
+func (s *scalar) Inv(a kyber.Scalar) kyber.Scalar {
+	scalarField.Inv(&s.v, &a.(*scalar).v)
+	return s
+}
+
+// Set to a fresh random or pseudo-random scalar
+func (s *scalar) Pick(rand cipher.Stream) kyber.Scalar {
+	scalarField.SetBig(&s.v, random.Int(scalarField.Modulus(), rand))
+	return s
+}
+
+// SetBytes sets s to b, interpreted as a big endian integer and reduced
+// modulo n.
+func (s *scalar) SetBytes(b []byte) kyber.Scalar {
+	scalarField.SetBig(&s.v, new(big.Int).SetBytes(b))
+	return s
+}
+
+// SetBytesWide sets s to b, interpreted as a big endian integer of any
+// length and reduced modulo n, such as a 64-byte hash.
+func (s *scalar) SetBytesWide(b []byte) kyber.Scalar {
+	return s.SetBytes(b)
+}
+
+// Exp sets s to a^e, in time that only depends on the public exponent e.
+// Negative exponents raise 1/a.
+func (s *scalar) Exp(a kyber.Scalar, e *big.Int) kyber.Scalar {
+	x := a.(*scalar).v
+	if e.Sign() < 0 {
+		scalarField.Inv(&x, &x)
+		e = new(big.Int).Neg(e)
+	}
+	scalarField.Exp(&s.v, &x, e)
+	return s
+}
+
+// Sqrt sets s to a square root of a and returns true if a is a square, or
+// returns false and leaves s unchanged otherwise. It runs in variable time.
+func (s *scalar) Sqrt(a kyber.Scalar) bool {
+	r := new(big.Int).ModSqrt(a.(*scalar).big(), scalarField.Modulus())
+	if r == nil {
+		return false
+	}
+	scalarField.SetBig(&s.v, r)
+	return true
+}
+
+// Legendre returns the Legendre symbol of s modulo n. It runs in variable
+// time.
+func (s *scalar) Legendre() int {
+	return big.Jacobi(s.big(), scalarField.Modulus())
+}
+
+func (s *scalar) big() *big.Int {
+	return new(big.Int).SetBytes(scalarField.Bytes(&s.v))
+}
+
+// String returns the string representation of this scalar (fixed length of
+// 32 bytes, big endian).
+func (s *scalar) String() string {
+	return hex.EncodeToString(scalarField.Bytes(&s.v))
+}
+
+// Encoded length of this object in bytes.
+func (s *scalar) MarshalSize() int {
+	return 32
+}
+
+// MarshalBinary returns the binary representation of this scalar.
+func (s *scalar) MarshalBinary() ([]byte, error) {
+	return scalarField.Bytes(&s.v), nil
+}
+
+// UnmarshalBinary reads the binary representation of a scalar, which must
+// be reduced modulo n.
+func (s *scalar) UnmarshalBinary(buf []byte) error {
+	if len(buf) != 32 {
+		return errors.New("secp256k1: wrong size buffer")
+	}
+	if !scalarField.SetBytes(&s.v, buf) {
+		return errors.New("secp256k1: scalar is not reduced modulo n")
+	}
+	return nil
+}
+
+// MarshalTo writes the binary representation of this scalar to the given
+// writer.
+func (s *scalar) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.ScalarMarshalTo(s, w)
+}
+
+// UnmarshalFrom reads the binary representation of a scalar from the given
+// reader.
+func (s *scalar) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.ScalarUnmarshalFrom(s, r)
+}
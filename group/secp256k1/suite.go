@@ -0,0 +1,71 @@
+package secp256k1
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake2xb"
+)
+
+// SuiteSecp256k1 implements some basic functionalities such as Group,
+// HashFactory, and XOFFactory.
+type SuiteSecp256k1 struct {
+	Curve
+	r cipher.Stream
+}
+
+// Hash returns a newly instanciated sha256 hash function.
+func (s *SuiteSecp256k1) Hash() hash.Hash {
+	return sha256.New()
+}
+
+// XOF returns an XOF which is implemented via the Blake2b hash.
+func (s *SuiteSecp256k1) XOF(key []byte) kyber.XOF {
+	return blake2xb.New(key)
+}
+
+func (s *SuiteSecp256k1) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteSecp256k1) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+// New implements the kyber.Encoding interface
+func (s *SuiteSecp256k1) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *SuiteSecp256k1) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+// NewBlakeSHA256Secp256k1 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the secp256k1 curve.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewBlakeSHA256Secp256k1() *SuiteSecp256k1 {
+	suite := new(SuiteSecp256k1)
+	return suite
+}
+
+// NewBlakeSHA256Secp256k1WithRand returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake2xb, SHA-256, and the secp256k1 curve.
+// It produces cryptographically random numbers via the provided stream r.
+func NewBlakeSHA256Secp256k1WithRand(r cipher.Stream) *SuiteSecp256k1 {
+	suite := new(SuiteSecp256k1)
+	suite.r = r
+	return suite
+}
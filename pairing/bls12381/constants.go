@@ -0,0 +1,85 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+func bigFromBase16(s string) *big.Int {
+	n, _ := new(big.Int).SetString(s, 16)
+	return n
+}
+
+// u is the absolute value of the BLS parameter that determines the prime. The
+// parameter itself is -u = -0xd201000000010000.
+var u = bigFromBase16("d201000000010000")
+
+// p is a prime over which we form a basic field: (u+1)²(u⁴-u²+1)/3 - u.
+var p = bigFromBase16("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+
+// Order is the number of elements in G₁, G₂ and GT: u⁴-u²+1.
+var Order = bigFromBase16("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+
+// twistCofactor is the cofactor of G₂ in the twist:
+// (u⁸+4u⁷+5u⁶-4u⁴-6u³-4u²+4u+13)/9.
+var twistCofactor = bigFromBase16("5d543a95414e7f1091d50792876a202cd91de4547085abaa68a205b2e5a7ddfa628f1cb4d9e82ef21537e293a6691ae1616ec6e786f0c70cf1c38e31c7238e5")
+
+// gtCofactor is (p¹²-1)/Order, the index of GT in the multiplicative group
+// of GF(p¹²).
+var gtCofactor = func() *big.Int {
+	n := new(big.Int).Exp(p, big.NewInt(12), nil)
+	n.Sub(n, big.NewInt(1))
+	return n.Div(n, Order)
+}()
+
+// pMinus2 is p-2, used to compute inverses in GF(p).
+var pMinus2 = new(big.Int).Sub(p, big.NewInt(2))
+
+// pMinus1Over2 is (p-1)/2, used to compute Legendre symbols.
+var pMinus1Over2 = new(big.Int).Rsh(p, 1)
+
+// pPlus1Over4 is (p+1)/4, used to compute square roots in GF(p).
+var pPlus1Over4 = new(big.Int).Rsh(new(big.Int).Add(p, big.NewInt(1)), 2)
+
+// pMinus3Over4 is (p-3)/4, used to compute square roots in GF(p²).
+var pMinus3Over4 = new(big.Int).Rsh(new(big.Int).Sub(p, big.NewInt(3)), 2)
+
+// uPlus1SquaredOver3 is (u+1)²/3, the first factor of the hard part of the
+// final exponentiation.
+var uPlus1SquaredOver3 = func() *big.Int {
+	n := new(big.Int).Add(u, big.NewInt(1))
+	n.Mul(n, n)
+	return n.Div(n, big.NewInt(3))
+}()
+
+// xiToPMinus1Over6 is ξ^((p-1)/6) where ξ = i+1.
+var xiToPMinus1Over6 = &gfP2{gfP{0xb2f66aad4ce5d646, 0x5842a06bfc497cec, 0xcf4895d42599d394, 0xc11b9cba40a8e8d0, 0x2e3813cbe5a0de89, 0x110eefda88847faf}, gfP{0x7089552b319d465, 0xc6695f92b50a8313, 0x97e83cccd117228f, 0xa35baecab2dc29ee, 0x1ce393ea5daace4d, 0x8f2220fb0fb66eb}}
+
+// xiToPMinus1Over3 is ξ^((p-1)/3) where ξ = i+1.
+var xiToPMinus1Over3 = &gfP2{gfP{0xcd03c9e48671f071, 0x5dab22461fcda5d2, 0x587042afd3851b95, 0x8eb60ebe01bacb9e, 0x3f97d6e83d050d2, 0x18f0206554638741}, gfP{0}}
+
+// xiTo2PMinus2Over3 is ξ^((2p-2)/3) where ξ = i+1.
+var xiTo2PMinus2Over3 = &gfP2{gfP{0}, gfP{0x890dc9e4867545c3, 0x2af322533285a5d5, 0x50880866309b7e2c, 0xa20d1b8c7e881024, 0x14e4f04fe2db9068, 0x14e56d3f1564853a}}
+
+// xiToPSquaredMinus1Over3 is ξ^((p²-1)/3) where ξ = i+1.
+var xiToPSquaredMinus1Over3 = &gfP{0x30f1361b798a64e8, 0xf3b8ddab7ece5a2a, 0x16a8ca3ac61577f7, 0xc26a2ff874fd029b, 0x3636b76660701c6e, 0x51ba4ab241b6160}
+
+// xiTo2PSquaredMinus2Over3 is ξ^((2p²-2)/3) where ξ = i+1.
+var xiTo2PSquaredMinus2Over3 = &gfP{0xcd03c9e48671f071, 0x5dab22461fcda5d2, 0x587042afd3851b95, 0x8eb60ebe01bacb9e, 0x3f97d6e83d050d2, 0x18f0206554638741}
+
+// xiToPSquaredMinus1Over6 is ξ^((p²-1)/6) where ξ = i+1.
+var xiToPSquaredMinus1Over6 = &gfP{0xecfb361b798dba3a, 0xc100ddb891865a2c, 0xec08ff1232bda8e, 0xd5c13cc6f1ca4721, 0x47222a47bf7b5c04, 0x110f184e51c5f59}
+
+// psiX is 1/ξ^((p-1)/3), used by the endomorphism ψ of the twist.
+var psiX = &gfP2{gfP{0x890dc9e4867545c3, 0x2af322533285a5d5, 0x50880866309b7e2c, 0xa20d1b8c7e881024, 0x14e4f04fe2db9068, 0x14e56d3f1564853a}, gfP{0}}
+
+// psiY is 1/ξ^((p-1)/2), used by the endomorphism ψ of the twist.
+var psiY = &gfP2{gfP{0x7bcfa7a25aa30fda, 0xdc17dec12a927e7c, 0x2f088dd86b4ebef1, 0xd1ca2087da74d4a7, 0x2da2596696cebc1d, 0xe2b7eedbbfd87d2}, gfP{0x3e2f585da55c9ad1, 0x4294213d86c18183, 0x382844c88b623732, 0x92ad2afd19103e18, 0x1d794e4fac7cf0b9, 0xbd592fc7d825ec8}}
+
+// p2 is p, represented as little-endian 64-bit words.
+var p2 = [6]uint64{0xb9feffffffffaaab, 0x1eabfffeb153ffff, 0x6730d2a0f6b0f624, 0x64774b84f38512bf, 0x4b1ba7b6434bacd7, 0x1a0111ea397fe69a}
+
+// np is the negative inverse of p, mod 2^64.
+const np = 0x89f3fffcfffcfffd
+
+// r2 is R^2 where R = 2^384 mod p.
+var r2 = &gfP{0xf4df1f341c341746, 0xa76e6a609d104f1, 0x8de5476c4c95b6d5, 0x67eb88a9939d83c0, 0x9a793e85b519952d, 0x11988fe592cae3aa}
@@ -0,0 +1,210 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// gfP is an element of GF(p), kept in Montgomery form with R = 2^384.
+type gfP [6]uint64
+
+func newGFp(x int64) (out *gfP) {
+	if x >= 0 {
+		out = &gfP{uint64(x)}
+	} else {
+		out = &gfP{uint64(-x)}
+		gfpNeg(out, out)
+	}
+
+	montEncode(out, out)
+	return out
+}
+
+func (e *gfP) String() string {
+	return fmt.Sprintf("%16.16x%16.16x%16.16x%16.16x%16.16x%16.16x", e[5], e[4], e[3], e[2], e[1], e[0])
+}
+
+func (e *gfP) Set(f *gfP) {
+	*e = *f
+}
+
+func (e *gfP) Invert(f *gfP) {
+	e.Exp(f, pMinus2)
+}
+
+// Exp sets e = f^power.
+func (e *gfP) Exp(f *gfP, power *big.Int) {
+	sum, t := &gfP{}, &gfP{}
+	sum.Set(newGFp(1))
+
+	for i := power.BitLen() - 1; i >= 0; i-- {
+		gfpMul(t, sum, sum)
+		if power.Bit(i) != 0 {
+			gfpMul(sum, t, f)
+		} else {
+			sum.Set(t)
+		}
+	}
+
+	e.Set(sum)
+}
+
+// IsSquare returns true iff f is a quadratic residue (or zero) in GF(p).
+func (e *gfP) IsSquare() bool {
+	t := &gfP{}
+	t.Exp(e, pMinus1Over2)
+	return *t != *newGFp(-1)
+}
+
+// Sqrt sets e to a square root of f and returns true iff f is a quadratic
+// residue. Since p = 3 mod 4, the square root is f^((p+1)/4).
+func (e *gfP) Sqrt(f *gfP) bool {
+	r, check := &gfP{}, &gfP{}
+	r.Exp(f, pPlus1Over4)
+	gfpMul(check, r, r)
+	if *check != *f {
+		return false
+	}
+	e.Set(r)
+	return true
+}
+
+// Sign returns the "sign" of e as defined by the sgn0 function of RFC 9380,
+// that is the parity of its canonical (non-Montgomery) representation.
+func (e *gfP) Sign() int {
+	t := &gfP{}
+	montDecode(t, e)
+	return int(t[0] & 1)
+}
+
+// IsLexicographicallyLargest returns true iff e is larger than -e when both
+// are seen as integers in [0, p), which is how the ZCash serialization format
+// tells apart the two square roots of a field element.
+func (e *gfP) IsLexicographicallyLargest() bool {
+	t := &gfP{}
+	montDecode(t, e)
+	var buf [48]byte
+	t.Marshal(buf[:])
+	return new(big.Int).SetBytes(buf[:]).Cmp(pMinus1Over2) > 0
+}
+
+// SetBig sets e to n mod p, in Montgomery form.
+func (e *gfP) SetBig(n *big.Int) {
+	var buf [48]byte
+	b := new(big.Int).Mod(n, p).Bytes()
+	copy(buf[48-len(b):], b)
+	e.Unmarshal(buf[:])
+	montEncode(e, e)
+}
+
+func (e *gfP) Marshal(out []byte) {
+	for w := uint(0); w < 6; w++ {
+		for b := uint(0); b < 8; b++ {
+			out[8*w+b] = byte(e[5-w] >> (56 - 8*b))
+		}
+	}
+}
+
+// Unmarshal sets e to the big-endian integer in the first 48 bytes of in,
+// which must be smaller than p.
+func (e *gfP) Unmarshal(in []byte) error {
+	for w := uint(0); w < 6; w++ {
+		e[5-w] = 0
+		for b := uint(0); b < 8; b++ {
+			e[5-w] += uint64(in[8*w+b]) << (56 - 8*b)
+		}
+	}
+	for i := 5; i >= 0; i-- {
+		if e[i] < p2[i] {
+			return nil
+		}
+		if e[i] > p2[i] {
+			return errors.New("bls12381: coordinate exceeds modulus")
+		}
+	}
+	return errors.New("bls12381: coordinate equals modulus")
+}
+
+func montEncode(c, a *gfP) { gfpMul(c, a, r2) }
+func montDecode(c, a *gfP) { gfpMul(c, a, &gfP{1}) }
+
+// gfpCarry sets a to a-p if a >= p, where head is the carry out of a.
+func gfpCarry(a *gfP, head uint64) {
+	var b gfP
+	var borrow uint64
+	for i := 0; i < 6; i++ {
+		b[i], borrow = bits.Sub64(a[i], p2[i], borrow)
+	}
+	_, borrow = bits.Sub64(head, 0, borrow)
+	if borrow == 0 {
+		*a = b
+	}
+}
+
+func gfpNeg(c, a *gfP) {
+	gfpSub(c, &gfP{}, a)
+}
+
+func gfpAdd(c, a, b *gfP) {
+	var t gfP
+	var carry uint64
+	for i := 0; i < 6; i++ {
+		t[i], carry = bits.Add64(a[i], b[i], carry)
+	}
+	gfpCarry(&t, carry)
+	*c = t
+}
+
+func gfpSub(c, a, b *gfP) {
+	var t gfP
+	var borrow uint64
+	for i := 0; i < 6; i++ {
+		t[i], borrow = bits.Sub64(a[i], b[i], borrow)
+	}
+	if borrow != 0 {
+		var carry uint64
+		for i := 0; i < 6; i++ {
+			t[i], carry = bits.Add64(t[i], p2[i], carry)
+		}
+	}
+	*c = t
+}
+
+// gfpMul sets c = a·b·R⁻¹ using the CIOS method from "Analyzing and Comparing
+// Montgomery Multiplication Algorithms", Koç, Acar and Kaliski.
+func gfpMul(c, a, b *gfP) {
+	var t [8]uint64
+	for i := 0; i < 6; i++ {
+		var carry uint64
+		for j := 0; j < 6; j++ {
+			carry, t[j] = madd(a[j], b[i], t[j], carry)
+		}
+		t[6], carry = bits.Add64(t[6], carry, 0)
+		t[7] = carry
+
+		m := t[0] * np
+		carry, _ = madd(m, p2[0], t[0], 0)
+		for j := 1; j < 6; j++ {
+			carry, t[j-1] = madd(m, p2[j], t[j], carry)
+		}
+		t[5], carry = bits.Add64(t[6], carry, 0)
+		t[6] = t[7] + carry
+	}
+
+	r := gfP{t[0], t[1], t[2], t[3], t[4], t[5]}
+	gfpCarry(&r, t[6])
+	*c = r
+}
+
+// madd returns the high and low words of a·b+c+d.
+func madd(a, b, c, d uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(a, b)
+	var carry uint64
+	lo, carry = bits.Add64(lo, c, 0)
+	hi += carry
+	lo, carry = bits.Add64(lo, d, 0)
+	hi += carry
+	return
+}
@@ -0,0 +1,229 @@
+package bls12381
+
+import (
+	"math/big"
+)
+
+// For details of the algorithms used, see "Multiplication and Squaring on
+// Pairing-Friendly Fields, Devegili et al.
+// http://eprint.iacr.org/2006/471.pdf.
+
+// gfP2 implements a field of size p² as a quadratic extension of the base field
+// where i²=-1.
+type gfP2 struct {
+	x, y gfP // value is xi+y.
+}
+
+func gfP2Decode(in *gfP2) *gfP2 {
+	out := &gfP2{}
+	montDecode(&out.x, &in.x)
+	montDecode(&out.y, &in.y)
+	return out
+}
+
+func (e *gfP2) String() string {
+	return "(" + e.x.String() + ", " + e.y.String() + ")"
+}
+
+func (e *gfP2) Set(a *gfP2) *gfP2 {
+	e.x.Set(&a.x)
+	e.y.Set(&a.y)
+	return e
+}
+
+func (e *gfP2) SetZero() *gfP2 {
+	e.x = gfP{0}
+	e.y = gfP{0}
+	return e
+}
+
+func (e *gfP2) SetOne() *gfP2 {
+	e.x = gfP{0}
+	e.y = *newGFp(1)
+	return e
+}
+
+func (e *gfP2) IsZero() bool {
+	zero := gfP{0}
+	return e.x == zero && e.y == zero
+}
+
+func (e *gfP2) IsOne() bool {
+	zero, one := gfP{0}, *newGFp(1)
+	return e.x == zero && e.y == one
+}
+
+func (e *gfP2) Conjugate(a *gfP2) *gfP2 {
+	e.y.Set(&a.y)
+	gfpNeg(&e.x, &a.x)
+	return e
+}
+
+func (e *gfP2) Neg(a *gfP2) *gfP2 {
+	gfpNeg(&e.x, &a.x)
+	gfpNeg(&e.y, &a.y)
+	return e
+}
+
+func (e *gfP2) Add(a, b *gfP2) *gfP2 {
+	gfpAdd(&e.x, &a.x, &b.x)
+	gfpAdd(&e.y, &a.y, &b.y)
+	return e
+}
+
+func (e *gfP2) Sub(a, b *gfP2) *gfP2 {
+	gfpSub(&e.x, &a.x, &b.x)
+	gfpSub(&e.y, &a.y, &b.y)
+	return e
+}
+
+// See "Multiplication and Squaring in Pairing-Friendly Fields",
+// http://eprint.iacr.org/2006/471.pdf
+func (e *gfP2) Mul(a, b *gfP2) *gfP2 {
+	tx, t := &gfP{}, &gfP{}
+	gfpMul(tx, &a.x, &b.y)
+	gfpMul(t, &b.x, &a.y)
+	gfpAdd(tx, tx, t)
+
+	ty := &gfP{}
+	gfpMul(ty, &a.y, &b.y)
+	gfpMul(t, &a.x, &b.x)
+	gfpSub(ty, ty, t)
+
+	e.x.Set(tx)
+	e.y.Set(ty)
+	return e
+}
+
+func (e *gfP2) MulScalar(a *gfP2, b *gfP) *gfP2 {
+	gfpMul(&e.x, &a.x, b)
+	gfpMul(&e.y, &a.y, b)
+	return e
+}
+
+// MulXi sets e=ξa where ξ=i+1 and then returns e.
+func (e *gfP2) MulXi(a *gfP2) *gfP2 {
+	// (xi+y)(i+1) = (x+y)i+(y-x)
+	tx := &gfP{}
+	gfpAdd(tx, &a.x, &a.y)
+
+	ty := &gfP{}
+	gfpSub(ty, &a.y, &a.x)
+
+	e.x.Set(tx)
+	e.y.Set(ty)
+	return e
+}
+
+func (e *gfP2) Square(a *gfP2) *gfP2 {
+	// Complex squaring algorithm:
+	// (xi+y)² = (x+y)(y-x) + 2*i*x*y
+	tx, ty := &gfP{}, &gfP{}
+	gfpSub(tx, &a.y, &a.x)
+	gfpAdd(ty, &a.x, &a.y)
+	gfpMul(ty, tx, ty)
+
+	gfpMul(tx, &a.x, &a.y)
+	gfpAdd(tx, tx, tx)
+
+	e.x.Set(tx)
+	e.y.Set(ty)
+	return e
+}
+
+func (e *gfP2) Invert(a *gfP2) *gfP2 {
+	// See "Implementing cryptographic pairings", M. Scott, section 3.2.
+	// ftp://136.206.11.249/pub/crypto/pairings.pdf
+	t1, t2 := &gfP{}, &gfP{}
+	gfpMul(t1, &a.x, &a.x)
+	gfpMul(t2, &a.y, &a.y)
+	gfpAdd(t1, t1, t2)
+
+	inv := &gfP{}
+	inv.Invert(t1)
+
+	gfpNeg(t1, &a.x)
+
+	gfpMul(&e.x, t1, inv)
+	gfpMul(&e.y, &a.y, inv)
+	return e
+}
+
+func (e *gfP2) Exp(a *gfP2, power *big.Int) *gfP2 {
+	sum := (&gfP2{}).SetOne()
+	t := &gfP2{}
+
+	for i := power.BitLen() - 1; i >= 0; i-- {
+		t.Square(sum)
+		if power.Bit(i) != 0 {
+			sum.Mul(t, a)
+		} else {
+			sum.Set(t)
+		}
+	}
+
+	e.Set(sum)
+	return e
+}
+
+// IsSquare returns true iff e is a quadratic residue (or zero) in GF(p²),
+// which is the case iff its norm x²+y² is a quadratic residue in GF(p).
+func (e *gfP2) IsSquare() bool {
+	t1, t2 := &gfP{}, &gfP{}
+	gfpMul(t1, &e.x, &e.x)
+	gfpMul(t2, &e.y, &e.y)
+	gfpAdd(t1, t1, t2)
+	return t1.IsSquare()
+}
+
+// Sqrt sets e to a square root of a and returns true iff a is a quadratic
+// residue. See "Square root computation over even extension fields", Adj
+// and Rodríguez-Henríquez, algorithm 9, which applies since p = 3 mod 4.
+// https://eprint.iacr.org/2012/685.pdf
+func (e *gfP2) Sqrt(a *gfP2) bool {
+	a1 := (&gfP2{}).Exp(a, pMinus3Over4)
+	x0 := (&gfP2{}).Mul(a1, a)
+	alpha := (&gfP2{}).Mul(a1, x0)
+
+	minusOne := (&gfP2{}).SetOne()
+	minusOne.Neg(minusOne)
+
+	r := &gfP2{}
+	if *alpha == *minusOne {
+		// r = i*x0
+		r.x.Set(&x0.y)
+		gfpNeg(&r.y, &x0.x)
+	} else {
+		b := (&gfP2{}).SetOne()
+		b.Add(b, alpha).Exp(b, pMinus1Over2)
+		r.Mul(b, x0)
+	}
+
+	check := (&gfP2{}).Square(r)
+	if *check != *a {
+		return false
+	}
+	e.Set(r)
+	return true
+}
+
+// Sign returns the "sign" of e as defined by the sgn0 function of RFC 9380
+// for a quadratic extension: the sign of y, or the sign of x if y is zero.
+func (e *gfP2) Sign() int {
+	zero := gfP{}
+	if e.y == zero {
+		return e.x.Sign()
+	}
+	return e.y.Sign()
+}
+
+// IsLexicographicallyLargest returns true iff e is larger than -e in the
+// order used by the ZCash serialization format, which compares x first and
+// y only if x is zero.
+func (e *gfP2) IsLexicographicallyLargest() bool {
+	zero := gfP{}
+	if e.x == zero {
+		return e.y.IsLexicographicallyLargest()
+	}
+	return e.x.IsLexicographicallyLargest()
+}
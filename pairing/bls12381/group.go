@@ -0,0 +1,98 @@
+package bls12381
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+)
+
+type groupG1 struct {
+	common
+}
+
+func (g *groupG1) String() string {
+	return "bls12381.G1"
+}
+
+func (g *groupG1) PointLen() int {
+	return newPointG1().MarshalSize()
+}
+
+func (g *groupG1) Point() kyber.Point {
+	return newPointG1()
+}
+
+// Cofactor returns the cofactor of G₁ in the curve, (u+1)²/3.
+func (g *groupG1) Cofactor() *big.Int {
+	return new(big.Int).Set(uPlus1SquaredOver3)
+}
+
+type groupG2 struct {
+	common
+}
+
+func (g *groupG2) String() string {
+	return "bls12381.G2"
+}
+
+func (g *groupG2) PointLen() int {
+	return newPointG2().MarshalSize()
+}
+
+func (g *groupG2) Point() kyber.Point {
+	return newPointG2()
+}
+
+// Cofactor returns the cofactor of G₂ in the twist.
+func (g *groupG2) Cofactor() *big.Int {
+	return new(big.Int).Set(twistCofactor)
+}
+
+type groupGT struct {
+	common
+}
+
+func (g *groupGT) String() string {
+	return "bls12381.GT"
+}
+
+func (g *groupGT) PointLen() int {
+	return newPointGT().MarshalSize()
+}
+
+func (g *groupGT) Point() kyber.Point {
+	return newPointGT()
+}
+
+// Cofactor returns (p¹²-1)/r, the cofactor of GT in the multiplicative
+// group of GF(p¹²).
+func (g *groupGT) Cofactor() *big.Int {
+	return new(big.Int).Set(gtCofactor)
+}
+
+// common functionalities across G1, G2, and GT
+type common struct{}
+
+func (c *common) ScalarLen() int {
+	return mod.NewInt64(0, Order).MarshalSize()
+}
+
+func (c *common) Scalar() kyber.Scalar {
+	return mod.NewInt64(0, Order)
+}
+
+func (c *common) Order() *big.Int {
+	return new(big.Int).Set(Order)
+}
+
+// IsPrimeOrder returns true, as decoding checks that points belong to the
+// subgroup of order r.
+func (c *common) IsPrimeOrder() bool {
+	return true
+}
+
+func (c *common) NewKey(rand cipher.Stream) kyber.Scalar {
+	return mod.NewInt64(0, Order).Pick(rand)
+}
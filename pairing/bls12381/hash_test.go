@@ -0,0 +1,126 @@
+package bls12381
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/stretchr/testify/require"
+)
+
+// affine returns the hex encoding of the affine coordinates of the given
+// field elements, in Montgomery form.
+func affine(coords ...*gfP) string {
+	var out []byte
+	t := &gfP{}
+	buf := make([]byte, 48)
+	for _, c := range coords {
+		montDecode(t, c)
+		t.Marshal(buf)
+		out = append(out, buf...)
+	}
+	return hex.EncodeToString(out)
+}
+
+func TestExpandMessageXMD(t *testing.T) {
+	// Test vectors from RFC 9380, appendix K.1.
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	vectors := []struct {
+		msg    string
+		length int
+		out    string
+	}{
+		{"", 0x20, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", 0x20, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, v := range vectors {
+		out := expandMessageXMD([]byte(v.msg), dst, v.length)
+		require.Equal(t, v.out, hex.EncodeToString(out))
+	}
+}
+
+func TestHashToG1(t *testing.T) {
+	// Test vectors from RFC 9380, appendix J.9.1.
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+	vectors := []struct {
+		msg, x, y string
+	}{
+		{"",
+			"052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+			"08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265"},
+		{"abc",
+			"03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903",
+			"0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d"},
+		{"abcdef0123456789",
+			"11e0b079dea29a68f0383ee94fed1b940995272407e3bb916bbf268c263ddd57a6a27200a784cbc248e84f357ce82d98",
+			"03a87ae2caf14e8ee52e51fa2ed8eefe80f02457004ba4d486d6aa1f517c0889501dc7413753f9599b099ebcbbd2d709"},
+		{"q128_" + strings.Repeat("q", 128),
+			"15f68eaa693b95ccb85215dc65fa81038d69629f70aeee0d0f677cf22285e7bf58d7cb86eefe8f2e9bc3f8cb84fac488",
+			"1807a1d50c29f430b8cafc4f8638dfeeadf51211e1602a5f184443076715f91bb90a48ba1e370edce6ae1062f5e6dd38"},
+		{"a512_" + strings.Repeat("a", 512),
+			"082aabae8b7dedb0e78aeb619ad3bfd9277a2f77ba7fad20ef6aabdc6c31d19ba5a6d12283553294c1825c4b3ca2dcfe",
+			"05b84ae5a942248eea39e1d91030458c40153f3b654ab7872d779ad1e942856a20c438e8d99bc8abfbf74729ce1f7ac8"},
+	}
+
+	suite := NewSuite()
+	for _, v := range vectors {
+		p := suite.G1().Point().(kyber.HashablePoint).Hash([]byte(v.msg), dst)
+		g := p.(*pointG1).g
+		g.MakeAffine()
+		require.Equal(t, v.x+v.y, affine(&g.x, &g.y))
+
+		buf, err := p.MarshalBinary()
+		require.Nil(t, err)
+		q := suite.G1().Point()
+		require.Nil(t, q.UnmarshalBinary(buf))
+		require.True(t, p.Equal(q))
+	}
+}
+
+func TestHashToG2(t *testing.T) {
+	// Test vectors from RFC 9380, appendix J.10.1. Coordinates are given as
+	// (c1, c0) for c0 + c1·i, as in the encoding of G2 points.
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	vectors := []struct {
+		msg, x1, x0, y1, y0 string
+	}{
+		{"",
+			"05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d",
+			"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a",
+			"12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6",
+			"0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92"},
+		{"abc",
+			"139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8",
+			"02c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6",
+			"00aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16",
+			"1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48"},
+	}
+
+	suite := NewSuite()
+	for _, v := range vectors {
+		p := suite.G2().Point().(kyber.HashablePoint).Hash([]byte(v.msg), dst)
+		g := p.(*pointG2).g
+		g.MakeAffine()
+		require.Equal(t, v.x1+v.x0+v.y1+v.y0, affine(&g.x.x, &g.x.y, &g.y.x, &g.y.y))
+
+		buf, err := p.MarshalBinary()
+		require.Nil(t, err)
+		q := suite.G2().Point()
+		require.Nil(t, q.UnmarshalBinary(buf))
+		require.True(t, p.Equal(q))
+	}
+}
+
+func TestHashDomainSeparation(t *testing.T) {
+	suite := NewSuite()
+	msg := []byte("message")
+	for _, g := range []kyber.Group{suite.G1(), suite.G2()} {
+		p1 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-1"))
+		p2 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-2"))
+		p3 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-1"))
+		require.False(t, p1.Equal(p2))
+		require.True(t, p1.Equal(p3))
+		require.False(t, p1.Equal(g.Point().Null()))
+	}
+}
@@ -0,0 +1,205 @@
+package bls12381
+
+// The twist used for BLS12-381 is a multiplicative one (M-type), so that the
+// untwisting isomorphism is (x', y') -> (x'/ω², y'/ω³). Substituting it into
+// the equation of a line through points of the twist and multiplying by ω⁴,
+// which lies in GF(p⁶) and is hence erased by the final exponentiation, lines
+// evaluated at a point q of G₁ take the sparse form c·ω⁴ + b·ω³ + a·ω with a,
+// b and c in GF(p²).
+
+// lineFunctionAdd returns the line through r and the affine point p,
+// evaluated at q, and sets rOut = r+p.
+func lineFunctionAdd(r, p *twistPoint, q *curvePoint) (a, b, c *gfP2, rOut *twistPoint) {
+	// With r = (X, Y, Z) in Jacobian coordinates, the slope of the line is
+	// λ = (y_p·Z³-Y)/(Z·(x_p·Z²-X)) = L/(Z·H). The line is scaled by Z·H.
+	z2 := (&gfP2{}).Square(&r.z)
+	z3 := (&gfP2{}).Mul(z2, &r.z)
+
+	L := (&gfP2{}).Mul(&p.y, z3)
+	L.Sub(L, &r.y)
+	H := (&gfP2{}).Mul(&p.x, z2)
+	H.Sub(H, &r.x)
+	ZH := (&gfP2{}).Mul(&r.z, H)
+
+	a = (&gfP2{}).Mul(L, &p.x)
+	t := (&gfP2{}).Mul(&p.y, ZH)
+	a.Sub(a, t)
+
+	b = (&gfP2{}).Neg(L)
+	b.MulScalar(b, &q.x)
+
+	c = (&gfP2{}).MulScalar(ZH, &q.y)
+
+	rOut = &twistPoint{}
+	rOut.Add(r, p)
+	return
+}
+
+// lineFunctionDouble returns the tangent to r, evaluated at q, and sets
+// rOut = 2r.
+func lineFunctionDouble(r *twistPoint, q *curvePoint) (a, b, c *gfP2, rOut *twistPoint) {
+	// With r = (X, Y, Z) in Jacobian coordinates, the slope of the tangent is
+	// λ = 3X²/(2Y·Z). The line is scaled by 2Y·Z³.
+	X2 := (&gfP2{}).Square(&r.x)
+	Y2 := (&gfP2{}).Square(&r.y)
+	z2 := (&gfP2{}).Square(&r.z)
+
+	a = (&gfP2{}).Mul(X2, &r.x)
+	t := (&gfP2{}).Add(a, a)
+	a.Add(a, t)
+	t.Add(Y2, Y2)
+	a.Sub(a, t)
+
+	b = (&gfP2{}).Mul(X2, z2)
+	t.Add(b, b)
+	b.Add(b, t).Neg(b)
+	b.MulScalar(b, &q.x)
+
+	c = (&gfP2{}).Mul(&r.y, &r.z)
+	c.Mul(c, z2)
+	c.Add(c, c)
+	c.MulScalar(c, &q.y)
+
+	rOut = &twistPoint{}
+	rOut.Double(r)
+	return
+}
+
+// mulLine sets ret = ret·(c·ω⁴ + b·ω³ + a·ω).
+func mulLine(ret *gfP12, a, b, c *gfP2) {
+	// ω⁴ = τ² and ω³ = τω, so the line is (bτ + a)ω + cτ².
+	l := &gfP12{}
+	l.x.y.Set(b)
+	l.x.z.Set(a)
+	l.y.x.Set(c)
+	ret.Mul(ret, l)
+}
+
+// miller implements the Miller loop for calculating the Optimal Ate pairing.
+// See algorithm 1 from http://cryptojedi.org/papers/dclxvi-20100714.pdf
+func miller(q *twistPoint, p *curvePoint) *gfP12 {
+	return multiMiller([]*twistPoint{q}, []*curvePoint{p})
+}
+
+// millerPair holds the state of the Miller loop for one pair of points.
+type millerPair struct {
+	aAffine, r *twistPoint
+	bAffine    *curvePoint
+}
+
+// multiMiller computes the product of the Miller loops of the pairs
+// (q[i], p[i]) in a single loop, so that the squarings of the result are
+// shared between all pairs. Pairs where either point is at infinity are
+// skipped, since their pairing is one.
+func multiMiller(q []*twistPoint, p []*curvePoint) *gfP12 {
+	ret := (&gfP12{}).SetOne()
+
+	pairs := make([]*millerPair, 0, len(q))
+	for i := range q {
+		if q[i].IsInfinity() || p[i].IsInfinity() {
+			continue
+		}
+		m := &millerPair{
+			aAffine: &twistPoint{},
+			r:       &twistPoint{},
+			bAffine: &curvePoint{},
+		}
+		m.aAffine.Set(q[i])
+		m.aAffine.MakeAffine()
+		m.bAffine.Set(p[i])
+		m.bAffine.MakeAffine()
+		m.r.Set(m.aAffine)
+		pairs = append(pairs, m)
+	}
+
+	// The loop runs over the bits of u, the parameter of the curve being -u.
+	for i := u.BitLen() - 2; i >= 0; i-- {
+		ret.Square(ret)
+
+		for _, m := range pairs {
+			a, b, c, newR := lineFunctionDouble(m.r, m.bAffine)
+			mulLine(ret, a, b, c)
+			m.r = newR
+
+			if u.Bit(i) == 0 {
+				continue
+			}
+
+			a, b, c, newR = lineFunctionAdd(m.r, m.aAffine, m.bAffine)
+			mulLine(ret, a, b, c)
+			m.r = newR
+		}
+	}
+
+	// Since the parameter is negative, the result has to be inverted, which
+	// after the final exponentiation amounts to the p⁶-Frobenius.
+	ret.Conjugate(ret)
+	return ret
+}
+
+// expU sets e = a^-u, assuming a is in the cyclotomic subgroup of GF(p¹²)
+// where the inverse is the conjugate, and then returns e.
+func (e *gfP12) expU(a *gfP12) *gfP12 {
+	e.Exp(a, u)
+	return e.Conjugate(e)
+}
+
+// finalExponentiation computes the (p¹²-1)/Order-th power of an element of
+// GF(p¹²) to obtain an element of GT. The hard part uses the decomposition of
+// (p⁴-p²+1)/Order into ((x-1)²/3)(x+p)(x²+p²-1)+1 where x = -u, see "Efficient
+// Final Exponentiation via Cyclotomic Structure for Pairings over Families of
+// Elliptic Curves", Hayashida, Hayasaka and Teruya,
+// https://eprint.iacr.org/2020/875.pdf
+// The result is then cubed, since RELIC, zkcrypto/bls12_381 and the other
+// common implementations of BLS12-381 raise to 3(p⁴-p²+1)/Order instead, which
+// saves the division by 3: the values of the pairing thus match theirs.
+func finalExponentiation(in *gfP12) *gfP12 {
+	t1 := &gfP12{}
+
+	// This is the p^6-Frobenius
+	t1.x.Neg(&in.x)
+	t1.y.Set(&in.y)
+
+	inv := &gfP12{}
+	inv.Invert(in)
+	t1.Mul(t1, inv)
+
+	t2 := (&gfP12{}).FrobeniusP2(t1)
+	t1.Mul(t1, t2)
+
+	// a = t1^((x-1)²/3) = t1^((u+1)²/3)
+	a := (&gfP12{}).Exp(t1, uPlus1SquaredOver3)
+
+	// b = a^(x+p)
+	b := (&gfP12{}).expU(a)
+	t2.Frobenius(a)
+	b.Mul(b, t2)
+
+	// c = b^(x²+p²-1)
+	c := (&gfP12{}).expU(b)
+	c.expU(c)
+	t2.FrobeniusP2(b)
+	c.Mul(c, t2)
+	t2.Conjugate(b)
+	c.Mul(c, t2)
+	c.Mul(c, t1)
+
+	t2.Square(c)
+	return c.Mul(c, t2)
+}
+
+func optimalAte(a *twistPoint, b *curvePoint) *gfP12 {
+	e := miller(a, b)
+	ret := finalExponentiation(e)
+
+	if a.IsInfinity() || b.IsInfinity() {
+		ret.SetOne()
+	}
+	return ret
+}
+
+// multiPair computes the product of the Optimal Ate pairings of the pairs
+// (a[i], b[i]) with a single Miller loop and a single final exponentiation.
+func multiPair(a []*twistPoint, b []*curvePoint) *gfP12 {
+	return finalExponentiation(multiMiller(a, b))
+}
@@ -0,0 +1,693 @@
+package bls12381
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/msm"
+)
+
+// Points of G₁ and G₂ are serialized in the compressed form used by ZCash and
+// most other BLS12-381 implementations: the big-endian encoding of x (x.c1
+// first for G₂) with the three most significant bits used as flags.
+const (
+	// serializationCompressed is set for compressed encodings.
+	serializationCompressed = 0x80
+	// serializationInfinity is set for the point at infinity.
+	serializationInfinity = 0x40
+	// serializationBigY is set when y is the lexicographically largest of
+	// the two square roots of x³+b.
+	serializationBigY = 0x20
+
+	serializationMask = serializationCompressed | serializationInfinity | serializationBigY
+)
+
+type pointG1 struct {
+	g *curvePoint
+}
+
+func newPointG1() *pointG1 {
+	p := &pointG1{g: &curvePoint{}}
+	return p
+}
+
+func (p *pointG1) Equal(q kyber.Point) bool {
+	x, _ := p.MarshalBinary()
+	y, _ := q.MarshalBinary()
+	return subtle.ConstantTimeCompare(x, y) == 1
+}
+
+func (p *pointG1) Null() kyber.Point {
+	p.g.SetInfinity()
+	return p
+}
+
+func (p *pointG1) Base() kyber.Point {
+	p.g.Set(curveGen)
+	return p
+}
+
+func (p *pointG1) Pick(rand cipher.Stream) kyber.Point {
+	s := mod.NewInt64(0, Order).Pick(rand)
+	p.Base()
+	p.g.Mul(p.g, &s.(*mod.Int).V)
+	return p
+}
+
+// Hash sets p to the hash of msg to G₁ under the domain separation tag dst,
+// following RFC 9380 with the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite.
+func (p *pointG1) Hash(msg, dst []byte) kyber.Point {
+	p.g.Set(hashToCurve(msg, dst))
+	return p
+}
+
+// HashSuite returns the identifier of the RFC 9380 suite of Hash.
+func (p *pointG1) HashSuite() string {
+	return "BLS12381G1_XMD:SHA-256_SSWU_RO_"
+}
+
+func (p *pointG1) Set(q kyber.Point) kyber.Point {
+	x := q.(*pointG1).g
+	p.g.Set(x)
+	return p
+}
+
+func (p *pointG1) Clone() kyber.Point {
+	q := newPointG1()
+	q.g.Set(p.g)
+	return q
+}
+
+func (p *pointG1) EmbedLen() int {
+	panic("bls12381.G1: unsupported operation")
+}
+
+func (p *pointG1) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	panic("bls12381.G1: unsupported operation")
+}
+
+func (p *pointG1) Data() ([]byte, error) {
+	panic("bls12381.G1: unsupported operation")
+}
+
+func (p *pointG1) Add(a, b kyber.Point) kyber.Point {
+	x := a.(*pointG1).g
+	y := b.(*pointG1).g
+	p.g.Add(x, y) // p = a + b
+	return p
+}
+
+func (p *pointG1) Sub(a, b kyber.Point) kyber.Point {
+	q := newPointG1()
+	return p.Add(a, q.Neg(b))
+}
+
+func (p *pointG1) Neg(q kyber.Point) kyber.Point {
+	x := q.(*pointG1).g
+	p.g.Neg(x)
+	return p
+}
+
+func (p *pointG1) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	if q == nil {
+		q = newPointG1().Base()
+	}
+	t := s.(*mod.Int).V
+	r := q.(*pointG1).g
+	p.g.Mul(r, &t)
+	return p
+}
+
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, in variable time.
+func (p *pointG1) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	return msm.MultiMulInt(p, scalarInts(scalars), points)
+}
+
+// Valid reports whether p is on the curve and in G₁.
+func (p *pointG1) Valid() bool {
+	c := *p.g
+	return c.IsOnCurve() && c.IsInSubgroup()
+}
+
+// IsSmallOrder reports whether the order of p divides the cofactor of G₁ in
+// the curve.
+func (p *pointG1) IsSmallOrder() bool {
+	t := &curvePoint{}
+	t.Mul(p.g, uPlus1SquaredOver3)
+	return t.IsInfinity()
+}
+
+// ClearCofactor sets p to the multiple of q by h_eff = u+1, which is in G₁.
+func (p *pointG1) ClearCofactor(q kyber.Point) kyber.Point {
+	p.g.Mul(q.(*pointG1).g, curveCofactor)
+	return p
+}
+
+func (p *pointG1) MarshalBinary() ([]byte, error) {
+	ret := make([]byte, p.MarshalSize())
+	p.g.MakeAffine()
+	if p.g.IsInfinity() {
+		ret[0] = serializationCompressed | serializationInfinity
+		return ret, nil
+	}
+
+	tmp := &gfP{}
+	montDecode(tmp, &p.g.x)
+	tmp.Marshal(ret)
+
+	ret[0] |= serializationCompressed
+	if p.g.y.IsLexicographicallyLargest() {
+		ret[0] |= serializationBigY
+	}
+	return ret, nil
+}
+
+func (p *pointG1) MarshalTo(w io.Writer) (int, error) {
+	buf, err := p.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
+
+func (p *pointG1) UnmarshalBinary(buf []byte) error {
+	if len(buf) < p.MarshalSize() {
+		return errors.New("bls12381.G1: not enough data")
+	}
+	if p.g == nil {
+		p.g = &curvePoint{}
+	}
+
+	flags := buf[0] & serializationMask
+	if flags&serializationCompressed == 0 {
+		return errors.New("bls12381.G1: point is not compressed")
+	}
+	x := make([]byte, p.ElementSize())
+	copy(x, buf)
+	x[0] &^= serializationMask
+
+	if flags&serializationInfinity != 0 {
+		if flags&serializationBigY != 0 || !isZero(x) {
+			return errors.New("bls12381.G1: malformed point")
+		}
+		p.g.SetInfinity()
+		return nil
+	}
+
+	if err := p.g.x.Unmarshal(x); err != nil {
+		return err
+	}
+	montEncode(&p.g.x, &p.g.x)
+	if !p.g.y.Sqrt(curveRHS(&p.g.x)) {
+		return errors.New("bls12381.G1: malformed point")
+	}
+	if p.g.y.IsLexicographicallyLargest() != (flags&serializationBigY != 0) {
+		gfpNeg(&p.g.y, &p.g.y)
+	}
+	p.g.z = *newGFp(1)
+	p.g.t = *newGFp(1)
+
+	if !p.g.IsInSubgroup() {
+		return errors.New("bls12381.G1: point is not in G1")
+	}
+	return nil
+}
+
+func (p *pointG1) UnmarshalFrom(r io.Reader) (int, error) {
+	buf := make([]byte, p.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	return n, p.UnmarshalBinary(buf)
+}
+
+func (p *pointG1) MarshalSize() int {
+	return p.ElementSize()
+}
+
+func (p *pointG1) ElementSize() int {
+	return 384 / 8
+}
+
+func (p *pointG1) String() string {
+	return "bls12381.G1" + p.g.String()
+}
+
+// scalarInts returns the integer values of the given scalars.
+func scalarInts(scalars []kyber.Scalar) []*big.Int {
+	v := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		v[i] = &s.(*mod.Int).V
+	}
+	return v
+}
+
+type pointG2 struct {
+	g *twistPoint
+}
+
+func newPointG2() *pointG2 {
+	p := &pointG2{g: &twistPoint{}}
+	return p
+}
+
+func (p *pointG2) Equal(q kyber.Point) bool {
+	x, _ := p.MarshalBinary()
+	y, _ := q.MarshalBinary()
+	return subtle.ConstantTimeCompare(x, y) == 1
+}
+
+func (p *pointG2) Null() kyber.Point {
+	p.g.SetInfinity()
+	return p
+}
+
+func (p *pointG2) Base() kyber.Point {
+	p.g.Set(twistGen)
+	return p
+}
+
+func (p *pointG2) Pick(rand cipher.Stream) kyber.Point {
+	s := mod.NewInt64(0, Order).Pick(rand)
+	p.Base()
+	p.g.Mul(p.g, &s.(*mod.Int).V)
+	return p
+}
+
+// Hash sets p to the hash of msg to G₂ under the domain separation tag dst,
+// following RFC 9380 with the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite.
+func (p *pointG2) Hash(msg, dst []byte) kyber.Point {
+	p.g.Set(hashToTwist(msg, dst))
+	return p
+}
+
+// HashSuite returns the identifier of the RFC 9380 suite of Hash.
+func (p *pointG2) HashSuite() string {
+	return "BLS12381G2_XMD:SHA-256_SSWU_RO_"
+}
+
+func (p *pointG2) Set(q kyber.Point) kyber.Point {
+	x := q.(*pointG2).g
+	p.g.Set(x)
+	return p
+}
+
+func (p *pointG2) Clone() kyber.Point {
+	q := newPointG2()
+	q.g.Set(p.g)
+	return q
+}
+
+func (p *pointG2) EmbedLen() int {
+	panic("bls12381.G2: unsupported operation")
+}
+
+func (p *pointG2) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	panic("bls12381.G2: unsupported operation")
+}
+
+func (p *pointG2) Data() ([]byte, error) {
+	panic("bls12381.G2: unsupported operation")
+}
+
+func (p *pointG2) Add(a, b kyber.Point) kyber.Point {
+	x := a.(*pointG2).g
+	y := b.(*pointG2).g
+	p.g.Add(x, y) // p = a + b
+	return p
+}
+
+func (p *pointG2) Sub(a, b kyber.Point) kyber.Point {
+	q := newPointG2()
+	return p.Add(a, q.Neg(b))
+}
+
+func (p *pointG2) Neg(q kyber.Point) kyber.Point {
+	x := q.(*pointG2).g
+	p.g.Neg(x)
+	return p
+}
+
+func (p *pointG2) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	if q == nil {
+		q = newPointG2().Base()
+	}
+	t := s.(*mod.Int).V
+	r := q.(*pointG2).g
+	p.g.Mul(r, &t)
+	return p
+}
+
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, in variable time.
+func (p *pointG2) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	return msm.MultiMulInt(p, scalarInts(scalars), points)
+}
+
+// Valid reports whether p is on the twist and in G₂.
+func (p *pointG2) Valid() bool {
+	c := *p.g
+	return c.IsOnCurve() && c.IsInSubgroup()
+}
+
+// IsSmallOrder reports whether the order of p divides the cofactor of G₂ in
+// the twist.
+func (p *pointG2) IsSmallOrder() bool {
+	t := &twistPoint{}
+	t.Mul(p.g, twistCofactor)
+	return t.IsInfinity()
+}
+
+// ClearCofactor sets p to the multiple of q by h_eff of RFC 9380, which is
+// in G₂.
+func (p *pointG2) ClearCofactor(q kyber.Point) kyber.Point {
+	p.g.Set(clearCofactorTwist(q.(*pointG2).g))
+	return p
+}
+
+func (p *pointG2) MarshalBinary() ([]byte, error) {
+	n := p.ElementSize()
+	ret := make([]byte, p.MarshalSize())
+	p.g.MakeAffine()
+	if p.g.IsInfinity() {
+		ret[0] = serializationCompressed | serializationInfinity
+		return ret, nil
+	}
+
+	tmp := &gfP{}
+	montDecode(tmp, &p.g.x.x)
+	tmp.Marshal(ret)
+	montDecode(tmp, &p.g.x.y)
+	tmp.Marshal(ret[n:])
+
+	ret[0] |= serializationCompressed
+	if p.g.y.IsLexicographicallyLargest() {
+		ret[0] |= serializationBigY
+	}
+	return ret, nil
+}
+
+func (p *pointG2) MarshalTo(w io.Writer) (int, error) {
+	buf, err := p.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
+
+func (p *pointG2) UnmarshalBinary(buf []byte) error {
+	n := p.ElementSize()
+	if len(buf) < p.MarshalSize() {
+		return errors.New("bls12381.G2: not enough data")
+	}
+	if p.g == nil {
+		p.g = &twistPoint{}
+	}
+
+	flags := buf[0] & serializationMask
+	if flags&serializationCompressed == 0 {
+		return errors.New("bls12381.G2: point is not compressed")
+	}
+	x := make([]byte, p.MarshalSize())
+	copy(x, buf)
+	x[0] &^= serializationMask
+
+	if flags&serializationInfinity != 0 {
+		if flags&serializationBigY != 0 || !isZero(x) {
+			return errors.New("bls12381.G2: malformed point")
+		}
+		p.g.SetInfinity()
+		return nil
+	}
+
+	if err := p.g.x.x.Unmarshal(x); err != nil {
+		return err
+	}
+	if err := p.g.x.y.Unmarshal(x[n:]); err != nil {
+		return err
+	}
+	montEncode(&p.g.x.x, &p.g.x.x)
+	montEncode(&p.g.x.y, &p.g.x.y)
+	if !p.g.y.Sqrt(twistRHS(&p.g.x)) {
+		return errors.New("bls12381.G2: malformed point")
+	}
+	if p.g.y.IsLexicographicallyLargest() != (flags&serializationBigY != 0) {
+		p.g.y.Neg(&p.g.y)
+	}
+	p.g.z.SetOne()
+	p.g.t.SetOne()
+
+	if !p.g.IsInSubgroup() {
+		return errors.New("bls12381.G2: point is not in G2")
+	}
+	return nil
+}
+
+func (p *pointG2) UnmarshalFrom(r io.Reader) (int, error) {
+	buf := make([]byte, p.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	return n, p.UnmarshalBinary(buf)
+}
+
+func (p *pointG2) MarshalSize() int {
+	return 2 * p.ElementSize()
+}
+
+func (p *pointG2) ElementSize() int {
+	return 384 / 8
+}
+
+func (p *pointG2) String() string {
+	return "bls12381.G2" + p.g.String()
+}
+
+type pointGT struct {
+	g *gfP12
+}
+
+func newPointGT() *pointGT {
+	p := &pointGT{g: &gfP12{}}
+	return p
+}
+
+func (p *pointGT) Equal(q kyber.Point) bool {
+	x, _ := p.MarshalBinary()
+	y, _ := q.MarshalBinary()
+	return subtle.ConstantTimeCompare(x, y) == 1
+}
+
+func (p *pointGT) Null() kyber.Point {
+	p.g.SetOne()
+	return p
+}
+
+func (p *pointGT) Base() kyber.Point {
+	p.g.Set(gfP12Gen)
+	return p
+}
+
+func (p *pointGT) Pick(rand cipher.Stream) kyber.Point {
+	s := mod.NewInt64(0, Order).Pick(rand)
+	p.Base()
+	p.g.Exp(p.g, &s.(*mod.Int).V)
+	return p
+}
+
+func (p *pointGT) Set(q kyber.Point) kyber.Point {
+	x := q.(*pointGT).g
+	p.g.Set(x)
+	return p
+}
+
+func (p *pointGT) Clone() kyber.Point {
+	q := newPointGT()
+	q.g.Set(p.g)
+	return q
+}
+
+func (p *pointGT) EmbedLen() int {
+	panic("bls12381.GT: unsupported operation")
+}
+
+func (p *pointGT) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	panic("bls12381.GT: unsupported operation")
+}
+
+func (p *pointGT) Data() ([]byte, error) {
+	panic("bls12381.GT: unsupported operation")
+}
+
+func (p *pointGT) Add(a, b kyber.Point) kyber.Point {
+	x := a.(*pointGT).g
+	y := b.(*pointGT).g
+	p.g.Mul(x, y)
+	return p
+}
+
+func (p *pointGT) Sub(a, b kyber.Point) kyber.Point {
+	q := newPointGT()
+	return p.Add(a, q.Neg(b))
+}
+
+func (p *pointGT) Neg(q kyber.Point) kyber.Point {
+	x := q.(*pointGT).g
+	p.g.Conjugate(x)
+	return p
+}
+
+func (p *pointGT) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
+	if q == nil {
+		q = newPointGT().Base()
+	}
+	t := s.(*mod.Int).V
+	r := q.(*pointGT).g
+	p.g.Exp(r, &t)
+	return p
+}
+
+// coefficients returns the coefficients of the element of GF(p¹²) in the
+// order in which they are serialized.
+func (p *pointGT) coefficients() []*gfP {
+	return []*gfP{
+		&p.g.x.x.x, &p.g.x.x.y, &p.g.x.y.x, &p.g.x.y.y, &p.g.x.z.x, &p.g.x.z.y,
+		&p.g.y.x.x, &p.g.y.x.y, &p.g.y.y.x, &p.g.y.y.y, &p.g.y.z.x, &p.g.y.z.y,
+	}
+}
+
+// Valid reports whether p is an element of GT, that is whether its order
+// divides r.
+func (p *pointGT) Valid() bool {
+	return (&gfP12{}).Exp(p.g, Order).IsOne()
+}
+
+// IsSmallOrder reports whether the order of p divides the cofactor of GT in
+// the multiplicative group of GF(p¹²).
+func (p *pointGT) IsSmallOrder() bool {
+	return (&gfP12{}).Exp(p.g, gtCofactor).IsOne()
+}
+
+// ClearCofactor sets p to q raised to the cofactor of GT in the
+// multiplicative group of GF(p¹²).
+func (p *pointGT) ClearCofactor(q kyber.Point) kyber.Point {
+	p.g.Exp(q.(*pointGT).g, gtCofactor)
+	return p
+}
+
+func (p *pointGT) MarshalBinary() ([]byte, error) {
+	n := p.ElementSize()
+	ret := make([]byte, p.MarshalSize())
+	temp := &gfP{}
+
+	for i, c := range p.coefficients() {
+		montDecode(temp, c)
+		temp.Marshal(ret[i*n:])
+	}
+	return ret, nil
+}
+
+func (p *pointGT) MarshalTo(w io.Writer) (int, error) {
+	buf, err := p.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
+
+func (p *pointGT) UnmarshalBinary(buf []byte) error {
+	n := p.ElementSize()
+	if len(buf) < p.MarshalSize() {
+		return errors.New("bls12381.GT: not enough data")
+	}
+
+	if p.g == nil {
+		p.g = &gfP12{}
+	}
+
+	for i, c := range p.coefficients() {
+		if err := c.Unmarshal(buf[i*n:]); err != nil {
+			return err
+		}
+		montEncode(c, c)
+	}
+
+	if !(&gfP12{}).Exp(p.g, Order).IsOne() {
+		return errors.New("bls12381.GT: element is not in GT")
+	}
+	return nil
+}
+
+func (p *pointGT) UnmarshalFrom(r io.Reader) (int, error) {
+	buf := make([]byte, p.MarshalSize())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	return n, p.UnmarshalBinary(buf)
+}
+
+func (p *pointGT) MarshalSize() int {
+	return 12 * p.ElementSize()
+}
+
+func (p *pointGT) ElementSize() int {
+	return 384 / 8
+}
+
+func (p *pointGT) String() string {
+	return "bls12381.GT" + p.g.String()
+}
+
+func (p *pointGT) Finalize() kyber.Point {
+	buf := finalExponentiation(p.g)
+	p.g.Set(buf)
+	return p
+}
+
+func (p *pointGT) Miller(p1, p2 kyber.Point) kyber.Point {
+	a := p1.(*pointG1).g
+	b := p2.(*pointG2).g
+	p.g.Set(miller(b, a))
+	return p
+}
+
+func (p *pointGT) Pair(p1, p2 kyber.Point) kyber.Point {
+	a := p1.(*pointG1).g
+	b := p2.(*pointG2).g
+	p.g.Set(optimalAte(b, a))
+	return p
+}
+
+// MultiPair sets p to the product of the pairings of the points of p1s in G₁
+// with the points of p2s in G₂, computed with a single Miller loop and a
+// single final exponentiation.
+func (p *pointGT) MultiPair(p1s, p2s []kyber.Point) kyber.Point {
+	if len(p1s) != len(p2s) {
+		panic("bls12381: mismatched number of points")
+	}
+	a := make([]*curvePoint, len(p1s))
+	b := make([]*twistPoint, len(p2s))
+	for i := range p1s {
+		a[i] = p1s[i].(*pointG1).g
+		b[i] = p2s[i].(*pointG2).g
+	}
+	p.g.Set(multiPair(b, a))
+	return p
+}
+
+func isZero(buf []byte) bool {
+	var acc byte
+	for _, b := range buf {
+		acc |= b
+	}
+	return acc == 0
+}
@@ -0,0 +1,159 @@
+package bn256
+
+import (
+	"errors"
+	"math/big"
+)
+
+// This file implements the compressed encodings of the points of G₁ and G₂,
+// which are selected with NewSuiteCompressed. Since p is larger than 2^255,
+// the coordinates leave no spare bit, so a point is written as a leading byte
+// followed by its x-coordinate: 0x02 or 0x03 when the sign of y, as defined
+// by the sgn0 function of RFC 9380, is 0 or 1 respectively, and 0x00 for the
+// point at infinity, in which case the rest of the encoding is zero. The
+// y-coordinate is recovered by a square root of x³+3 in GF(p), or of x³+3/ξ
+// in GF(p²) for G₂, whose x-coordinate xi+y is written as x followed by y.
+
+const (
+	compressedInfinity = 0x00
+	compressedEven     = 0x02
+	compressedOdd      = 0x03
+)
+
+func (p *pointG1) marshalCompressed() []byte {
+	ret := make([]byte, p.MarshalSize())
+	p.g.MakeAffine()
+	if p.g.IsInfinity() {
+		return ret
+	}
+	ret[0] = compressedEven | byte(p.g.y.Sign())
+	tmp := &gfP{}
+	montDecode(tmp, &p.g.x)
+	tmp.Marshal(ret[1:])
+	return ret
+}
+
+func (p *pointG1) unmarshalCompressed(buf []byte) error {
+	if len(buf) < p.MarshalSize() {
+		return errors.New("bn256.G1: not enough data")
+	}
+	buf = buf[:p.MarshalSize()]
+	if p.g == nil {
+		p.g = &curvePoint{}
+	}
+	if buf[0] == compressedInfinity {
+		if !allZero(buf[1:]) {
+			return errors.New("bn256.G1: malformed point")
+		}
+		p.g.SetInfinity()
+		return nil
+	}
+	if buf[0] != compressedEven && buf[0] != compressedOdd {
+		return errors.New("bn256.G1: malformed point")
+	}
+	if !canonicalCoordinates(buf[1:], p.ElementSize()) {
+		return errors.New("bn256.G1: coordinate is not reduced modulo p")
+	}
+
+	x, y := &gfP{}, &gfP{}
+	x.Unmarshal(buf[1:])
+	montEncode(x, x)
+	if !y.Sqrt(curveRHS(x)) {
+		return errors.New("bn256.G1: point is not on the curve")
+	}
+	if y.Sign() != int(buf[0]&1) {
+		gfpNeg(y, y)
+	}
+	p.g.x.Set(x)
+	p.g.y.Set(y)
+	p.g.z = *newGFp(1)
+	p.g.t = *newGFp(1)
+	return nil
+}
+
+func (p *pointG2) marshalCompressed() []byte {
+	n := p.ElementSize()
+	ret := make([]byte, p.MarshalSize())
+	p.g.MakeAffine()
+	if p.g.IsInfinity() {
+		return ret
+	}
+	ret[0] = compressedEven | byte(p.g.y.Sign())
+	tmp := &gfP{}
+	montDecode(tmp, &p.g.x.x)
+	tmp.Marshal(ret[1:])
+	montDecode(tmp, &p.g.x.y)
+	tmp.Marshal(ret[1+n:])
+	return ret
+}
+
+// unmarshalCompressed also checks that the decoded point belongs to G₂
+// rather than to another subgroup of the twist.
+func (p *pointG2) unmarshalCompressed(buf []byte) error {
+	n := p.ElementSize()
+	if len(buf) < p.MarshalSize() {
+		return errors.New("bn256.G2: not enough data")
+	}
+	buf = buf[:p.MarshalSize()]
+	if p.g == nil {
+		p.g = &twistPoint{}
+	}
+	if buf[0] == compressedInfinity {
+		if !allZero(buf[1:]) {
+			return errors.New("bn256.G2: malformed point")
+		}
+		p.g.SetInfinity()
+		return nil
+	}
+	if buf[0] != compressedEven && buf[0] != compressedOdd {
+		return errors.New("bn256.G2: malformed point")
+	}
+	if !canonicalCoordinates(buf[1:], n) {
+		return errors.New("bn256.G2: coordinate is not reduced modulo p")
+	}
+
+	x, y := &gfP2{}, &gfP2{}
+	x.x.Unmarshal(buf[1:])
+	x.y.Unmarshal(buf[1+n:])
+	montEncode(&x.x, &x.x)
+	montEncode(&x.y, &x.y)
+	if !y.Sqrt(twistRHS(x)) {
+		return errors.New("bn256.G2: point is not on the curve")
+	}
+	if y.Sign() != int(buf[0]&1) {
+		y.Neg(y)
+	}
+
+	q := &twistPoint{}
+	q.x.Set(x)
+	q.y.Set(y)
+	q.z.SetOne()
+	q.t.SetOne()
+	t := &twistPoint{}
+	t.Mul(q, Order)
+	if !t.IsInfinity() {
+		return errors.New("bn256.G2: point is not in G2")
+	}
+	p.g.Set(q)
+	return nil
+}
+
+// canonicalCoordinates returns true iff all the n-byte big-endian integers
+// in buf are smaller than p.
+func canonicalCoordinates(buf []byte, n int) bool {
+	for i := 0; i < len(buf); i += n {
+		if new(big.Int).SetBytes(buf[i:i+n]).Cmp(p) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// allZero returns true iff all the bytes of buf are zero.
+func allZero(buf []byte) bool {
+	var acc byte
+	for _, b := range buf {
+		acc |= b
+	}
+	return acc == 0
+}
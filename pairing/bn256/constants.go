@@ -19,6 +19,23 @@ var p = bigFromBase10("650005496956466037327964387423599057428253581076230035718
 // order-1 = (2**5) * 3 * 5743 * 280941149 * 130979359433191 * 491513138693455212421542731357 * 6518589491078791937
 var Order = bigFromBase10("65000549695646603732796438742359905742570406053903786389881062969044166799969")
 
+// gtCofactor is (p¹²-1)/Order, the index of GT in the multiplicative group
+// of GF(p¹²).
+var gtCofactor = func() *big.Int {
+	n := new(big.Int).Exp(p, big.NewInt(12), nil)
+	n.Sub(n, big.NewInt(1))
+	return n.Div(n, Order)
+}()
+
+// pMinus1Over2 is (p-1)/2, used to compute Legendre symbols.
+var pMinus1Over2 = new(big.Int).Rsh(p, 1)
+
+// pPlus1Over4 is (p+1)/4, used to compute square roots in GF(p).
+var pPlus1Over4 = new(big.Int).Rsh(new(big.Int).Add(p, big.NewInt(1)), 2)
+
+// pMinus3Over4 is (p-3)/4, used to compute square roots in GF(p²).
+var pMinus3Over4 = new(big.Int).Rsh(new(big.Int).Sub(p, big.NewInt(3)), 2)
+
 // xiToPMinus1Over6 is ξ^((p-1)/6) where ξ = i+3.
 var xiToPMinus1Over6 = &gfP2{gfP{0x25af52988477cdb7, 0x3d81a455ddced86a, 0x227d012e872c2431, 0x179198d3ea65d05}, gfP{0x7407634dd9cca958, 0x36d5bd6c7afb8f26, 0xf4b1c32cebd880fa, 0x6aa7869306f455f}}
 
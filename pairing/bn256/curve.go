@@ -151,12 +151,17 @@ func (c *curvePoint) Add(a, b *curvePoint) {
 
 func (c *curvePoint) Double(a *curvePoint) {
 	// See http://hyperelliptic.org/EFD/g1p/auto-code/shortw/jacobian-0/doubling/dbl-2009-l.op3
+	// z is computed first, as a.z is not needed afterwards, so that c may
+	// alias a.
+	t, t2 := &gfP{}, &gfP{}
+	gfpMul(t, &a.y, &a.z)
+	gfpAdd(&c.z, t, t)
+
 	A, B, C := &gfP{}, &gfP{}, &gfP{}
 	gfpMul(A, &a.x, &a.x)
 	gfpMul(B, &a.y, &a.y)
 	gfpMul(C, B, B)
 
-	t, t2 := &gfP{}, &gfP{}
 	gfpAdd(t, &a.x, B)
 	gfpMul(t2, t, t)
 	gfpSub(t, t2, A)
@@ -177,9 +182,6 @@ func (c *curvePoint) Double(a *curvePoint) {
 	gfpSub(&c.y, d, &c.x)
 	gfpMul(t2, e, &c.y)
 	gfpSub(&c.y, t2, t)
-
-	gfpMul(t, &a.y, &a.z)
-	gfpAdd(&c.z, t, t)
 }
 
 func (c *curvePoint) Mul(a *curvePoint, scalar *big.Int) {
@@ -198,6 +200,114 @@ func (c *curvePoint) Mul(a *curvePoint, scalar *big.Int) {
 	c.Set(sum)
 }
 
+// MulGLV sets c = scalar·a like Mul, about twice as fast, by splitting the
+// scalar into two halves with the GLV method: a must be in G₁, which is the
+// whole curve, so that (βx, y) is λ·a.
+func (c *curvePoint) MulGLV(a *curvePoint, scalar *big.Int) {
+	k := curveLattice.decompose(scalar)
+
+	var base [2]curvePoint
+	base[0].Set(a)
+	base[1].Set(a)
+	gfpMul(&base[1].x, &base[1].x, xiTo2PSquaredMinus2Over3)
+	for i := range k {
+		if k[i].Sign() < 0 {
+			k[i].Neg(k[i])
+			base[i].Neg(&base[i])
+		}
+	}
+
+	// precomp[i + 4j] = i·base[0] + j·base[1] for 2-bit windows.
+	var precomp [16]curvePoint
+	precomp[0].SetInfinity()
+	for i := 1; i < len(precomp); i++ {
+		if i&3 != 0 {
+			precomp[i].Add(&precomp[i-1], &base[0])
+		} else {
+			precomp[i].Add(&precomp[i-4], &base[1])
+		}
+	}
+
+	sum, t := &curvePoint{}, &curvePoint{}
+	sum.SetInfinity()
+	for _, w := range windows(k, 2) {
+		t.Double(sum)
+		sum.Double(t)
+		if w != 0 {
+			t.Add(sum, &precomp[w])
+			sum.Set(t)
+		}
+	}
+
+	c.Set(sum)
+}
+
+// curveTable holds the multiples d·16^i·a of an affine point a, for 1 ≤ d ≤ 8
+// and 0 ≤ i ≤ 64, which let MulTable replace the doublings of Mul by table
+// lookups.
+type curveTable struct {
+	a         curvePoint
+	multiples [65][8]curvePoint
+}
+
+func newCurveTable(a *curvePoint) *curveTable {
+	t := &curveTable{}
+	t.a.Set(a)
+	t.a.MakeAffine()
+
+	ai := &curvePoint{}
+	ai.Set(&t.a)
+	for i := range t.multiples {
+		m := &t.multiples[i]
+		m[0].Set(ai)
+		for j := 1; j < len(m); j++ {
+			m[j].Add(&m[j-1], ai)
+		}
+		ai.Double(&m[7])
+	}
+	return t
+}
+
+// matches returns true iff the table was computed for a.
+func (t *curveTable) matches(a *curvePoint) bool {
+	return t.a.x == a.x && t.a.y == a.y && t.a.z == a.z
+}
+
+// MulTable sets c = scalar·a, where t is the table of a and scalar is
+// smaller than 2^256.
+func (c *curvePoint) MulTable(t *curveTable, scalar *big.Int) {
+	sum, neg := &curvePoint{}, &curvePoint{}
+	sum.SetInfinity()
+
+	for i, d := range signedDigits(scalar) {
+		if d > 0 {
+			sum.Add(sum, &t.multiples[i][d-1])
+		} else if d < 0 {
+			neg.Neg(&t.multiples[i][-d-1])
+			sum.Add(sum, neg)
+		}
+	}
+
+	c.Set(sum)
+}
+
+// signedDigits returns the base-16 digits of n < 2^256 shifted to lie in
+// [-8, 8), so that n = Σ d[i]·16^i with a final digit that is 0 or 1.
+func signedDigits(n *big.Int) (d [65]int) {
+	var buf [32]byte
+	b := n.Bytes()
+	copy(buf[32-len(b):], b)
+
+	carry := 0
+	for i := 0; i < 64; i++ {
+		v := int(buf[31-i/2]>>(4*uint(i%2))&15) + carry
+		carry = (v + 8) >> 4
+		d[i] = v - carry<<4
+	}
+	d[64] = carry
+	return d
+}
+
 func (c *curvePoint) MakeAffine() {
 	if c.z == *newGFp(1) {
 		return
@@ -1,6 +1,9 @@
 package bn256
 
-import "fmt"
+import (
+	"fmt"
+	"math/big"
+)
 
 type gfP [4]uint64
 
@@ -47,6 +50,61 @@ func (e *gfP) Invert(f *gfP) {
 	e.Set(sum)
 }
 
+// Exp sets e = f^power.
+func (e *gfP) Exp(f *gfP, power *big.Int) {
+	sum, t := &gfP{}, &gfP{}
+	sum.Set(newGFp(1))
+
+	for i := power.BitLen() - 1; i >= 0; i-- {
+		gfpMul(t, sum, sum)
+		if power.Bit(i) != 0 {
+			gfpMul(sum, t, f)
+		} else {
+			sum.Set(t)
+		}
+	}
+
+	e.Set(sum)
+}
+
+// IsSquare returns true iff f is a quadratic residue (or zero) in GF(p).
+func (e *gfP) IsSquare() bool {
+	t := &gfP{}
+	t.Exp(e, pMinus1Over2)
+	return *t != *newGFp(-1)
+}
+
+// Sqrt sets e to a square root of f and returns true iff f is a quadratic
+// residue. Since p = 3 mod 4, the square root is f^((p+1)/4).
+func (e *gfP) Sqrt(f *gfP) bool {
+	r, check := &gfP{}, &gfP{}
+	r.Exp(f, pPlus1Over4)
+	gfpMul(check, r, r)
+	if *check != *f {
+		return false
+	}
+	e.Set(r)
+	return true
+}
+
+// Sign returns the "sign" of e as defined by the sgn0 function of RFC 9380,
+// that is the parity of its canonical (non-Montgomery) representation.
+func (e *gfP) Sign() int {
+	t := &gfP{}
+	montDecode(t, e)
+	return int(t[0] & 1)
+}
+
+// SetBig sets e to n mod p, in Montgomery form.
+func (e *gfP) SetBig(n *big.Int) {
+	var buf [32]byte
+	b := new(big.Int).Mod(n, p).Bytes()
+	copy(buf[32-len(b):], b)
+	*e = gfP{}
+	e.Unmarshal(buf[:])
+	montEncode(e, e)
+}
+
 func (e *gfP) Marshal(out []byte) {
 	for w := uint(0); w < 4; w++ {
 		for b := uint(0); b < 8; b++ {
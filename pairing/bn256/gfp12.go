@@ -189,6 +189,100 @@ func (e *gfP12) Exp(a *gfP12, power *big.Int) *gfP12 {
 	return e
 }
 
+// IsCyclotomic returns true iff e is in the cyclotomic subgroup of order
+// p⁴-p²+1, which contains GT and the results of finalExponentiation, that is
+// iff e^(p⁴)·e = e^(p²).
+func (e *gfP12) IsCyclotomic() bool {
+	if e.IsZero() {
+		return false
+	}
+	t := (&gfP12{}).FrobeniusP4(e)
+	t.Mul(t, e)
+	return *t == *(&gfP12{}).FrobeniusP2(e)
+}
+
+// CyclotomicSquare sets e = a², where a must be in the cyclotomic subgroup,
+// with about half the cost of Square.
+// See "Faster Squaring in the Cyclotomic Subgroup of Sixth Degree
+// Extensions", Granger and Scott, section 3.2.
+// http://eprint.iacr.org/2009/565.pdf
+func (e *gfP12) CyclotomicSquare(a *gfP12) *gfP12 {
+	// With a = (g₀ + g₁τ + g₂τ²) + (h₀ + h₁τ + h₂τ²)ω, the result only
+	// depends on the squares of g₀ + h₁ω, h₀ + g₂ω and g₁ + h₂ω in
+	// GF(p²)[ω]/(ω²-ξ).
+	t0 := (&gfP2{}).Square(&a.x.y)
+	t1 := (&gfP2{}).Square(&a.y.z)
+	t6 := (&gfP2{}).Add(&a.x.y, &a.y.z)
+	t6.Square(t6).Sub(t6, t0).Sub(t6, t1)
+
+	t2 := (&gfP2{}).Square(&a.y.x)
+	t3 := (&gfP2{}).Square(&a.x.z)
+	t7 := (&gfP2{}).Add(&a.y.x, &a.x.z)
+	t7.Square(t7).Sub(t7, t2).Sub(t7, t3)
+
+	t4 := (&gfP2{}).Square(&a.x.x)
+	t5 := (&gfP2{}).Square(&a.y.y)
+	t8 := (&gfP2{}).Add(&a.x.x, &a.y.y)
+	t8.Square(t8).Sub(t8, t4).Sub(t8, t5).MulXi(t8)
+
+	t0.MulXi(t0).Add(t0, t1)
+	t2.MulXi(t2).Add(t2, t3)
+	t4.MulXi(t4).Add(t4, t5)
+
+	// g₀ = 3t₀ - 2g₀, h₁ = 3t₆ + 2h₁, and so on.
+	e.y.z.Sub(t0, &a.y.z)
+	e.y.z.Add(&e.y.z, &e.y.z).Add(&e.y.z, t0)
+	e.y.y.Sub(t2, &a.y.y)
+	e.y.y.Add(&e.y.y, &e.y.y).Add(&e.y.y, t2)
+	e.y.x.Sub(t4, &a.y.x)
+	e.y.x.Add(&e.y.x, &e.y.x).Add(&e.y.x, t4)
+
+	e.x.z.Add(t8, &a.x.z)
+	e.x.z.Add(&e.x.z, &e.x.z).Add(&e.x.z, t8)
+	e.x.y.Add(t6, &a.x.y)
+	e.x.y.Add(&e.x.y, &e.x.y).Add(&e.x.y, t6)
+	e.x.x.Add(t7, &a.x.x)
+	e.x.x.Add(&e.x.x, &e.x.x).Add(&e.x.x, t7)
+	return e
+}
+
+// CyclotomicExp sets e = a^power like Exp, where a must be in the cyclotomic
+// subgroup, with cyclotomic squarings and signed 4-bit windows: the inverse
+// of a is then its conjugate, which also handles negative powers.
+func (e *gfP12) CyclotomicExp(a *gfP12, power *big.Int) *gfP12 {
+	if power.Sign() < 0 {
+		e.CyclotomicExp(a, new(big.Int).Neg(power))
+		return e.Conjugate(e)
+	}
+	if power.BitLen() > 256 {
+		return e.Exp(a, power)
+	}
+
+	// precomp[d-1] = a^d for 1 ≤ d ≤ 8.
+	var precomp [8]gfP12
+	precomp[0].Set(a)
+	for i := 1; i < len(precomp); i++ {
+		precomp[i].Mul(&precomp[i-1], a)
+	}
+
+	sum := (&gfP12{}).SetOne()
+	t := &gfP12{}
+	digits := signedDigits(power)
+	for i := (power.BitLen() + 3) / 4; i >= 0; i-- {
+		for j := 0; j < 4; j++ {
+			sum.CyclotomicSquare(sum)
+		}
+		if d := digits[i]; d > 0 {
+			sum.Mul(sum, &precomp[d-1])
+		} else if d < 0 {
+			sum.Mul(sum, t.Conjugate(&precomp[-d-1]))
+		}
+	}
+
+	e.Set(sum)
+	return e
+}
+
 func (e *gfP12) Square(a *gfP12) *gfP12 {
 	// Complex squaring algorithm
 	v0 := (&gfP6{}).Mul(&a.x, &a.y)
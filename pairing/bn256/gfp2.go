@@ -1,5 +1,9 @@
 package bn256
 
+import (
+	"math/big"
+)
+
 // For details of the algorithms used, see "Multiplication and Squaring on
 // Pairing-Friendly Fields, Devegili et al.
 // http://eprint.iacr.org/2006/471.pdf.
@@ -148,3 +152,71 @@ func (e *gfP2) Invert(a *gfP2) *gfP2 {
 	gfpMul(&e.y, &a.y, inv)
 	return e
 }
+
+func (e *gfP2) Exp(a *gfP2, power *big.Int) *gfP2 {
+	sum := (&gfP2{}).SetOne()
+	t := &gfP2{}
+
+	for i := power.BitLen() - 1; i >= 0; i-- {
+		t.Square(sum)
+		if power.Bit(i) != 0 {
+			sum.Mul(t, a)
+		} else {
+			sum.Set(t)
+		}
+	}
+
+	e.Set(sum)
+	return e
+}
+
+// IsSquare returns true iff e is a quadratic residue (or zero) in GF(p²),
+// which is the case iff its norm x²+y² is a quadratic residue in GF(p).
+func (e *gfP2) IsSquare() bool {
+	t1, t2 := &gfP{}, &gfP{}
+	gfpMul(t1, &e.x, &e.x)
+	gfpMul(t2, &e.y, &e.y)
+	gfpAdd(t1, t1, t2)
+	return t1.IsSquare()
+}
+
+// Sqrt sets e to a square root of a and returns true iff a is a quadratic
+// residue. See "Square root computation over even extension fields", Adj
+// and Rodríguez-Henríquez, algorithm 9, which applies since p = 3 mod 4.
+// https://eprint.iacr.org/2012/685.pdf
+func (e *gfP2) Sqrt(a *gfP2) bool {
+	a1 := (&gfP2{}).Exp(a, pMinus3Over4)
+	x0 := (&gfP2{}).Mul(a1, a)
+	alpha := (&gfP2{}).Mul(a1, x0)
+
+	minusOne := (&gfP2{}).SetOne()
+	minusOne.Neg(minusOne)
+
+	r := &gfP2{}
+	if *alpha == *minusOne {
+		// r = i*x0
+		r.x.Set(&x0.y)
+		gfpNeg(&r.y, &x0.x)
+	} else {
+		b := (&gfP2{}).SetOne()
+		b.Add(b, alpha).Exp(b, pMinus1Over2)
+		r.Mul(b, x0)
+	}
+
+	check := (&gfP2{}).Square(r)
+	if *check != *a {
+		return false
+	}
+	e.Set(r)
+	return true
+}
+
+// Sign returns the "sign" of e as defined by the sgn0 function of RFC 9380
+// for a quadratic extension: the sign of y, or the sign of x if y is zero.
+func (e *gfP2) Sign() int {
+	zero := gfP{}
+	if e.y == zero {
+		return e.x.Sign()
+	}
+	return e.y.Sign()
+}
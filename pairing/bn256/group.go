@@ -2,6 +2,7 @@ package bn256
 
 import (
 	"crypto/cipher"
+	"math/big"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/mod"
@@ -9,6 +10,7 @@ import (
 
 type groupG1 struct {
 	common
+	compressed bool
 }
 
 func (g *groupG1) String() string {
@@ -16,15 +18,34 @@ func (g *groupG1) String() string {
 }
 
 func (g *groupG1) PointLen() int {
-	return newPointG1().MarshalSize()
+	return g.Point().MarshalSize()
 }
 
 func (g *groupG1) Point() kyber.Point {
-	return newPointG1()
+	p := newPointG1()
+	p.compressed = g.compressed
+	return p
+}
+
+// Cofactor returns 1, since G₁ is the whole curve.
+func (g *groupG1) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+func (g *groupG1) IsPrimeOrder() bool {
+	return true
+}
+
+// PrimeOrder returns the same as IsPrimeOrder.
+//
+// Deprecated: use IsPrimeOrder.
+func (g *groupG1) PrimeOrder() bool {
+	return g.IsPrimeOrder()
 }
 
 type groupG2 struct {
 	common
+	compressed bool
 }
 
 func (g *groupG2) String() string {
@@ -32,11 +53,30 @@ func (g *groupG2) String() string {
 }
 
 func (g *groupG2) PointLen() int {
-	return newPointG2().MarshalSize()
+	return g.Point().MarshalSize()
 }
 
 func (g *groupG2) Point() kyber.Point {
-	return newPointG2()
+	p := newPointG2()
+	p.compressed = g.compressed
+	return p
+}
+
+// Cofactor returns the cofactor of G₂ in the twist, 2p-n.
+func (g *groupG2) Cofactor() *big.Int {
+	return new(big.Int).Set(twistCofactor)
+}
+
+// IsPrimeOrder returns true, as decoding checks that points belong to G₂.
+func (g *groupG2) IsPrimeOrder() bool {
+	return true
+}
+
+// PrimeOrder returns the same as IsPrimeOrder.
+//
+// Deprecated: use IsPrimeOrder.
+func (g *groupG2) PrimeOrder() bool {
+	return g.IsPrimeOrder()
 }
 
 type groupGT struct {
@@ -55,6 +95,25 @@ func (g *groupGT) Point() kyber.Point {
 	return newPointGT()
 }
 
+// Cofactor returns (p¹²-1)/n, the cofactor of GT in the multiplicative
+// group of GF(p¹²).
+func (g *groupGT) Cofactor() *big.Int {
+	return new(big.Int).Set(gtCofactor)
+}
+
+// IsPrimeOrder returns false, as decoding does not check that elements
+// belong to GT.
+func (g *groupGT) IsPrimeOrder() bool {
+	return false
+}
+
+// PrimeOrder returns the same as IsPrimeOrder.
+//
+// Deprecated: use IsPrimeOrder.
+func (g *groupGT) PrimeOrder() bool {
+	return g.IsPrimeOrder()
+}
+
 // common functionalities across G1, G2, and GT
 type common struct{}
 
@@ -66,8 +125,8 @@ func (c *common) Scalar() kyber.Scalar {
 	return mod.NewInt64(0, Order)
 }
 
-func (c *common) PrimeOrder() bool {
-	return true
+func (c *common) Order() *big.Int {
+	return new(big.Int).Set(Order)
 }
 
 func (c *common) NewKey(rand cipher.Stream) kyber.Scalar {
@@ -0,0 +1,267 @@
+package bn256
+
+import (
+	"crypto/sha256"
+	"math/big"
+)
+
+// This file implements hashing to G₁ and G₂ following RFC 9380, "Hashing to
+// Elliptic Curves" (https://www.rfc-editor.org/rfc/rfc9380). Messages are
+// expanded with expand_message_xmd using SHA-256, and the resulting field
+// elements are mapped to the curve with the Shallue-van de Woestijne map,
+// which, unlike the simplified SWU map, supports curves with A=0 directly.
+// The suites are therefore BN256G1_XMD:SHA-256_SVDW_RO_ and
+// BN256G2_XMD:SHA-256_SVDW_RO_.
+
+// hashFieldLen is L in RFC 9380: the number of bytes hashed to obtain a
+// single element of GF(p) with a bias of at most 2^-128.
+const hashFieldLen = 48
+
+// twistCofactor is the cofactor of G₂ in the twist: 2p-n.
+var twistCofactor = new(big.Int).Sub(new(big.Int).Lsh(p, 1), Order)
+
+// expandMessageXMD implements expand_message_xmd from RFC 9380 section 5.3.1
+// with SHA-256. The length must be at most 255*32 bytes.
+func expandMessageXMD(msg, dst []byte, length int) []byte {
+	h := sha256.New()
+	bLen := h.Size()
+	ell := (length + bLen - 1) / bLen
+	if ell > 255 {
+		panic("bn256: requested hash output too long")
+	}
+	if len(dst) > 255 {
+		h.Write([]byte("H2C-OVERSIZE-DST-"))
+		h.Write(dst)
+		dst = h.Sum(nil)
+		h.Reset()
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	h.Write(make([]byte, h.BlockSize()))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	out := make([]byte, 0, ell*bLen)
+	out = append(out, bi...)
+	for i := 2; i <= ell; i++ {
+		// b_i = H(strxor(b_0, b_(i-1)) || I2OSP(i, 1) || DST_prime)
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(bi[:0])
+		out = append(out, bi...)
+	}
+	return out[:length]
+}
+
+// hashToField implements hash_to_field from RFC 9380 section 5.2, returning
+// count*m elements of GF(p), in Montgomery form.
+func hashToField(msg, dst []byte, count, m int) []gfP {
+	uniform := expandMessageXMD(msg, dst, count*m*hashFieldLen)
+	out := make([]gfP, count*m)
+	for i := range out {
+		n := new(big.Int).SetBytes(uniform[i*hashFieldLen : (i+1)*hashFieldLen])
+		out[i].SetBig(n)
+	}
+	return out
+}
+
+// svdwG1 holds the constants of the Shallue-van de Woestijne map to the curve
+// y²=x³+3, computed as described in RFC 9380 section 6.6.1 with Z = 1.
+var svdwG1 = func() (c struct{ z, c1, c2, c3, c4 gfP }) {
+	c.z = *newGFp(1)
+	gz := curveRHS(&c.z)
+	c.c1 = *gz
+
+	// c2 = -Z/2
+	two := newGFp(2)
+	two.Invert(two)
+	gfpMul(&c.c2, &c.z, two)
+	gfpNeg(&c.c2, &c.c2)
+
+	// c3 = sqrt(-g(Z) * 3Z²), with sgn0(c3) = 0
+	threeZ2 := &gfP{}
+	gfpMul(threeZ2, &c.z, &c.z)
+	gfpMul(threeZ2, threeZ2, newGFp(3))
+	gfpMul(&c.c3, gz, threeZ2)
+	gfpNeg(&c.c3, &c.c3)
+	if !c.c3.Sqrt(&c.c3) {
+		panic("bn256: invalid SVDW constant")
+	}
+	if c.c3.Sign() == 1 {
+		gfpNeg(&c.c3, &c.c3)
+	}
+
+	// c4 = -4g(Z) / 3Z²
+	threeZ2.Invert(threeZ2)
+	gfpMul(&c.c4, gz, newGFp(-4))
+	gfpMul(&c.c4, &c.c4, threeZ2)
+	return c
+}()
+
+// svdwG2 holds the constants of the Shallue-van de Woestijne map to the twist
+// y²=x³+3/ξ, computed as described in RFC 9380 section 6.6.1 with Z = 1.
+var svdwG2 = func() (c struct{ z, c1, c2, c3, c4 gfP2 }) {
+	c.z.SetOne()
+	gz := twistRHS(&c.z)
+	c.c1.Set(gz)
+
+	// c2 = -Z/2
+	two := &gfP2{gfP{0}, *newGFp(2)}
+	two.Invert(two)
+	c.c2.Mul(&c.z, two).Neg(&c.c2)
+
+	// c3 = sqrt(-g(Z) * 3Z²), with sgn0(c3) = 0
+	threeZ2 := (&gfP2{}).Square(&c.z)
+	threeZ2.MulScalar(threeZ2, newGFp(3))
+	c.c3.Mul(gz, threeZ2).Neg(&c.c3)
+	if !c.c3.Sqrt(&c.c3) {
+		panic("bn256: invalid SVDW constant")
+	}
+	if c.c3.Sign() == 1 {
+		c.c3.Neg(&c.c3)
+	}
+
+	// c4 = -4g(Z) / 3Z²
+	threeZ2.Invert(threeZ2)
+	c.c4.MulScalar(gz, newGFp(-4)).Mul(&c.c4, threeZ2)
+	return c
+}()
+
+// curveRHS returns x³+3.
+func curveRHS(x *gfP) *gfP {
+	r := &gfP{}
+	gfpMul(r, x, x)
+	gfpMul(r, r, x)
+	gfpAdd(r, r, curveB)
+	return r
+}
+
+// twistRHS returns x³+3/ξ.
+func twistRHS(x *gfP2) *gfP2 {
+	r := (&gfP2{}).Square(x)
+	r.Mul(r, x).Add(r, twistB)
+	return r
+}
+
+// mapToCurve implements the Shallue-van de Woestijne map from GF(p) to the
+// curve y²=x³+3, as described in RFC 9380 section 6.6.1.
+func mapToCurve(u *gfP) *curvePoint {
+	c := &svdwG1
+	one := newGFp(1)
+	tv1, tv2, tv3, tv4 := &gfP{}, &gfP{}, &gfP{}, &gfP{}
+
+	gfpMul(tv1, u, u)
+	gfpMul(tv1, tv1, &c.c1)
+	gfpAdd(tv2, one, tv1)
+	gfpSub(tv1, one, tv1)
+	gfpMul(tv3, tv1, tv2)
+	tv3.Invert(tv3) // inv0, since 0^(p-2) = 0
+	gfpMul(tv4, u, tv1)
+	gfpMul(tv4, tv4, tv3)
+	gfpMul(tv4, tv4, &c.c3)
+
+	x1, x2, x3 := &gfP{}, &gfP{}, &gfP{}
+	gfpSub(x1, &c.c2, tv4)
+	gfpAdd(x2, &c.c2, tv4)
+	gfpMul(x3, tv2, tv2)
+	gfpMul(x3, x3, tv3)
+	gfpMul(x3, x3, x3)
+	gfpMul(x3, x3, &c.c4)
+	gfpAdd(x3, x3, &c.z)
+
+	x := x3
+	if curveRHS(x1).IsSquare() {
+		x = x1
+	} else if curveRHS(x2).IsSquare() {
+		x = x2
+	}
+
+	y := &gfP{}
+	if !y.Sqrt(curveRHS(x)) {
+		panic("bn256: SVDW map failed")
+	}
+	if u.Sign() != y.Sign() {
+		gfpNeg(y, y)
+	}
+
+	return &curvePoint{x: *x, y: *y, z: *newGFp(1), t: *newGFp(1)}
+}
+
+// mapToTwist implements the Shallue-van de Woestijne map from GF(p²) to the
+// twist y²=x³+3/ξ, as described in RFC 9380 section 6.6.1.
+func mapToTwist(u *gfP2) *twistPoint {
+	c := &svdwG2
+	one := (&gfP2{}).SetOne()
+
+	tv1 := (&gfP2{}).Square(u)
+	tv1.Mul(tv1, &c.c1)
+	tv2 := (&gfP2{}).Add(one, tv1)
+	tv1.Sub(one, tv1)
+	tv3 := (&gfP2{}).Mul(tv1, tv2)
+	tv3.Invert(tv3) // inv0, since the inverse of 0 is computed as 0
+	tv4 := (&gfP2{}).Mul(u, tv1)
+	tv4.Mul(tv4, tv3).Mul(tv4, &c.c3)
+
+	x1 := (&gfP2{}).Sub(&c.c2, tv4)
+	x2 := (&gfP2{}).Add(&c.c2, tv4)
+	x3 := (&gfP2{}).Square(tv2)
+	x3.Mul(x3, tv3).Square(x3).Mul(x3, &c.c4).Add(x3, &c.z)
+
+	x := x3
+	if twistRHS(x1).IsSquare() {
+		x = x1
+	} else if twistRHS(x2).IsSquare() {
+		x = x2
+	}
+
+	y := &gfP2{}
+	if !y.Sqrt(twistRHS(x)) {
+		panic("bn256: SVDW map failed")
+	}
+	if u.Sign() != y.Sign() {
+		y.Neg(y)
+	}
+
+	r := &twistPoint{}
+	r.x.Set(x)
+	r.y.Set(y)
+	r.z.SetOne()
+	r.t.SetOne()
+	return r
+}
+
+// hashToCurve hashes msg to a point of G₁ using the domain separation tag dst.
+func hashToCurve(msg, dst []byte) *curvePoint {
+	u := hashToField(msg, dst, 2, 1)
+	q0 := mapToCurve(&u[0])
+	q1 := mapToCurve(&u[1])
+	// G₁ is the whole curve, so no cofactor clearing is needed.
+	q0.Add(q0, q1)
+	return q0
+}
+
+// hashToTwist hashes msg to a point of G₂ using the domain separation tag dst.
+func hashToTwist(msg, dst []byte) *twistPoint {
+	u := hashToField(msg, dst, 2, 2)
+	// An element of GF(p²) is encoded as (c0, c1) for c0 + c1·i.
+	q0 := mapToTwist(&gfP2{u[1], u[0]})
+	q1 := mapToTwist(&gfP2{u[3], u[2]})
+	q0.Add(q0, q1)
+	q0.Mul(q0, twistCofactor)
+	return q0
+}
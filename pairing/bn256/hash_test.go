@@ -0,0 +1,105 @@
+package bn256
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMessageXMD(t *testing.T) {
+	// Test vectors from RFC 9380, appendix K.1.
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	vectors := []struct {
+		msg    string
+		length int
+		out    string
+	}{
+		{"", 0x20, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", 0x20, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, v := range vectors {
+		out := expandMessageXMD([]byte(v.msg), dst, v.length)
+		require.Equal(t, v.out, hex.EncodeToString(out))
+	}
+}
+
+func TestHashToG1(t *testing.T) {
+	// Known answers of BN256G1_XMD:SHA-256_SVDW_RO_ computed by
+	// testdata/hash_to_curve.py, an independent implementation of RFC 9380.
+	dst := []byte("QUUX-V01-CS02-with-BN256G1_XMD:SHA-256_SVDW_RO_")
+	vectors := []struct {
+		msg, x, y string
+	}{
+		{"",
+			"24806e759b4a774899c983aad9032f5bf7570d2320896c99a181e2fdeb12bb33",
+			"76b08d168cf7755a0a094881a48f5a19f32d7146bb66d5914b7488422291150d"},
+		{"abc",
+			"64ae303357450c22fee03159020f3d847de6d27a19d58da9cf4f2688ce42e31e",
+			"5e5afd6b978975f0d2644ff3f3e611580f442b1aaa09faf74fc6ad6762b5ec55"},
+		{"abcdef0123456789",
+			"13dd8022b75d2f8b2305255257fb2b5fdc283ca9e08a68aaebfa074a3e22fb24",
+			"5424bfa2f8b514bb406b846d1da502eaef57e720622fed8fe79c005e20d803ce"},
+	}
+
+	suite := NewSuite()
+	for _, v := range vectors {
+		p := suite.G1().Point().(kyber.HashablePoint).Hash([]byte(v.msg), dst)
+		buf, err := p.MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, v.x+v.y, hex.EncodeToString(buf))
+
+		q := suite.G1().Point()
+		require.Nil(t, q.UnmarshalBinary(buf))
+		require.True(t, p.Equal(q))
+	}
+}
+
+func TestHashToG2(t *testing.T) {
+	// Known answers of BN256G2_XMD:SHA-256_SVDW_RO_ computed by
+	// testdata/hash_to_curve.py, an independent implementation of RFC 9380.
+	// Coordinates are given as (c1, c0) for c0 + c1·i, as in the encoding of
+	// G2 points.
+	dst := []byte("QUUX-V01-CS02-with-BN256G2_XMD:SHA-256_SVDW_RO_")
+	vectors := []struct {
+		msg, x1, x0, y1, y0 string
+	}{
+		{"",
+			"1c2518531a4b4240910c31f9085d3027ccd82dbbf53dd99fa334a4483ab6525c",
+			"7bb728255447706f2590cc645d084cb78dcb55f4aa972aee5ade472ecbd7cb36",
+			"81a016d957226639395e0bcc89e094c61431c8e477776275a1e784d154f4982f",
+			"44a7a049774a316880560bb9d95cd61afb6cd405d05092f38914cca6ab1d7ffb"},
+		{"abc",
+			"33d1a4858d102902e7ccfffd70db1c4afe6c80fe14be557747407c71c7d137e5",
+			"2a965aa3ba873a3517642259b32cc8ffb296c78793ec6e9a9c03d36de6ad40bd",
+			"4ba074caa9764fea8c4f6ac402b6a3940f0dbec26577ac473289f5ad4bf8f1f8",
+			"8a2e255e08762b65b4a42d140ecb64acfb13f6efb4929048dc05df8000b88cd8"},
+	}
+
+	suite := NewSuite()
+	minusOne := suite.G2().Scalar().Neg(suite.G2().Scalar().One())
+	for _, v := range vectors {
+		p := suite.G2().Point().(kyber.HashablePoint).Hash([]byte(v.msg), dst)
+		buf, err := p.MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, "01"+v.x1+v.x0+v.y1+v.y0, hex.EncodeToString(buf))
+
+		// p must be in G2: (n-1)·p = -p.
+		q := suite.G2().Point().Mul(minusOne, p)
+		require.True(t, q.Equal(suite.G2().Point().Neg(p)))
+	}
+}
+
+func TestHashDomainSeparation(t *testing.T) {
+	suite := NewSuite()
+	msg := []byte("message")
+	for _, g := range []kyber.Group{suite.G1(), suite.G2()} {
+		p1 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-1"))
+		p2 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-2"))
+		p3 := g.Point().(kyber.HashablePoint).Hash(msg, []byte("tag-1"))
+		require.False(t, p1.Equal(p2))
+		require.True(t, p1.Equal(p3))
+		require.False(t, p1.Equal(g.Point().Null()))
+	}
+}
@@ -0,0 +1,180 @@
+package bn256
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// This file implements the kyber.Hiding interface for G₁ with Elligator
+// Squared, from "Elligator Squared: Uniform Points on Elliptic Curves of
+// Prime Order as Uniform Random Strings" by Mehdi Tibouchi
+// (https://eprint.iacr.org/2014/043). A point P is represented by two field
+// elements u₁ and u₂ such that P = f(u₁) + f(u₂), where f is the
+// Shallue-van de Woestijne map used for hashing. Picking u₁ at random, and
+// u₂ at random among the preimages of P - f(u₁) with a probability
+// proportional to their number, yields a pair that is statistically close to
+// uniform in GF(p)² whatever P is, so that encoding never fails. Each field
+// element is then written on hashFieldLen bytes as a random integer
+// congruent to it modulo p, which makes the whole representation close to a
+// uniform string.
+
+// svdwMaxPreimages bounds the number of preimages of a point under
+// mapToCurve: at most two for each of its three candidate x-coordinates.
+const svdwMaxPreimages = 6
+
+// HideLen returns the length of the uniform representations of points.
+func (p *pointG1) HideLen() int {
+	return 2 * hashFieldLen
+}
+
+// HideEncode returns a uniform representation of p. It never fails.
+func (p *pointG1) HideEncode(rand cipher.Stream) []byte {
+	d := &curvePoint{}
+	for {
+		u1 := randomGFp(rand)
+		d.Neg(mapToCurve(u1))
+		d.Add(d, p.g)
+		d.MakeAffine()
+		if d.IsInfinity() {
+			continue
+		}
+		if u2, ok := svdwPreimage(d, randomIndex(svdwMaxPreimages, rand)); ok {
+			rep := make([]byte, 2*hashFieldLen)
+			hideField(rep[:hashFieldLen], u1, rand)
+			hideField(rep[hashFieldLen:], u2, rand)
+			return rep
+		}
+	}
+}
+
+// HideDecode sets p to the point represented by rep, which must be
+// HideLen bytes long.
+func (p *pointG1) HideDecode(rep []byte) {
+	u1, u2 := &gfP{}, &gfP{}
+	u1.SetBig(new(big.Int).SetBytes(rep[:hashFieldLen]))
+	u2.SetBig(new(big.Int).SetBytes(rep[hashFieldLen:]))
+	q := mapToCurve(u1)
+	q.Add(q, mapToCurve(u2))
+	p.g.Set(q)
+}
+
+// svdwPreimage returns the j-th preimage of the affine point q under
+// mapToCurve, for 0 ≤ j < svdwMaxPreimages, or false if there is none.
+// Every preimage has exactly one index, that of the first candidate of
+// svdwCandidate equal to it.
+func svdwPreimage(q *curvePoint, j int) (*gfP, bool) {
+	u, ok := svdwCandidate(q, j)
+	if !ok {
+		return nil, false
+	}
+	r := mapToCurve(u)
+	if r.x != q.x || r.y != q.y {
+		return nil, false
+	}
+	for i := 0; i < j; i++ {
+		if v, ok := svdwCandidate(q, i); ok && *v == *u {
+			return nil, false
+		}
+	}
+	return u, true
+}
+
+// svdwCandidate returns the j-th value of u for which one of the candidate
+// x-coordinates computed by mapToCurve equals the x-coordinate of the affine
+// point q, or false if there is no such value. The first two candidates come
+// from x₁, the next two from x₂ and the last two from x₃.
+func svdwCandidate(q *curvePoint, j int) (*gfP, bool) {
+	c := &svdwG1
+	one := newGFp(1)
+	u, s, t := &gfP{}, &gfP{}, &gfP{}
+
+	switch j / 2 {
+	case 0, 1:
+		// x₁ and x₂ are c₂ ∓ c₃u/(1+c₁u²), so that u is a root of
+		// c₁tu² - c₃u + t, with t = c₂-x for x₁ and x-c₂ for x₂.
+		gfpSub(t, &c.c2, &q.x)
+		if j/2 == 1 {
+			gfpNeg(t, t)
+		}
+		// u = (c₃ ± sqrt(c₃² - 4c₁t²)) / 2c₁t
+		gfpMul(s, t, t)
+		gfpMul(s, s, &c.c1)
+		gfpMul(s, s, newGFp(4))
+		gfpMul(u, &c.c3, &c.c3)
+		gfpSub(s, u, s)
+		if !s.Sqrt(s) {
+			return nil, false
+		}
+		if j%2 == 1 {
+			gfpNeg(s, s)
+		}
+		gfpAdd(u, &c.c3, s)
+		gfpMul(t, t, &c.c1)
+		gfpAdd(t, t, t)
+		t.Invert(t)
+		gfpMul(u, u, t)
+
+	case 2:
+		// x₃ is Z + c₄((1+c₁u²)/(1-c₁u²))², so that c₁u² = (r-1)/(r+1)
+		// for r = ± sqrt((x-Z)/c₄).
+		gfpSub(s, &q.x, &c.z)
+		t.Invert(&c.c4)
+		gfpMul(s, s, t)
+		if !s.Sqrt(s) {
+			return nil, false
+		}
+		if j%2 == 1 {
+			gfpNeg(s, s)
+		}
+		gfpAdd(t, s, one)
+		gfpMul(t, t, &c.c1)
+		t.Invert(t)
+		gfpSub(s, s, one)
+		gfpMul(s, s, t)
+		if !u.Sqrt(s) {
+			return nil, false
+		}
+		// The sign of u is that of y.
+		if u.Sign() != q.y.Sign() {
+			gfpNeg(u, u)
+		}
+	}
+	return u, true
+}
+
+// randomGFp returns an element of GF(p) picked uniformly at random.
+func randomGFp(rand cipher.Stream) *gfP {
+	e := &gfP{}
+	e.SetBig(random.Int(p, rand))
+	return e
+}
+
+// randomIndex returns an integer picked uniformly at random in [0, n).
+func randomIndex(n int, rand cipher.Stream) int {
+	return int(random.Int(big.NewInt(int64(n)), rand).Int64())
+}
+
+// hideField writes to out, which is hashFieldLen bytes long, a random integer
+// congruent to u modulo p. Integers are picked below the largest multiple of
+// p that fits, so that their distribution is within 2^-128 of the uniform
+// one when u is uniform.
+func hideField(out []byte, u *gfP, rand cipher.Stream) {
+	var buf [32]byte
+	t := &gfP{}
+	montDecode(t, u)
+	t.Marshal(buf[:])
+
+	max := new(big.Int).Lsh(big.NewInt(1), uint(8*len(out)))
+	max.Div(max, p)
+	n := random.Int(max, rand)
+	n.Mul(n, p)
+	n.Add(n, new(big.Int).SetBytes(buf[:]))
+
+	b := n.Bytes()
+	for i := range out {
+		out[i] = 0
+	}
+	copy(out[len(out)-len(b):], b)
+}
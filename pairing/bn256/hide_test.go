@@ -0,0 +1,51 @@
+package bn256
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSVDWPreimages(t *testing.T) {
+	rand := random.New()
+	for i := 0; i < 20; i++ {
+		u := randomGFp(rand)
+		q := mapToCurve(u)
+		found := 0
+		for j := 0; j < svdwMaxPreimages; j++ {
+			if v, ok := svdwPreimage(q, j); ok {
+				r := mapToCurve(v)
+				require.Equal(t, q.x, r.x)
+				require.Equal(t, q.y, r.y)
+				if *v == *u {
+					found++
+				}
+			}
+		}
+		require.Equal(t, 1, found, "iteration %d", i)
+	}
+}
+
+func TestHideG1(t *testing.T) {
+	suite := NewSuite()
+	rand := random.New()
+	for i := 0; i < 10; i++ {
+		p := suite.G1().Point().Pick(rand)
+		rep := p.(kyber.Hiding).HideEncode(rand)
+		require.Equal(t, p.(kyber.Hiding).HideLen(), len(rep))
+
+		q := suite.G1().Point()
+		q.(kyber.Hiding).HideDecode(rep)
+		require.True(t, p.Equal(q), "iteration %d", i)
+	}
+
+	// Any string decodes to a point of G₁.
+	rep := random.Bits(uint(8*suite.G1().Point().(kyber.Hiding).HideLen()), true, rand)
+	p := suite.G1().Point()
+	p.(kyber.Hiding).HideDecode(rep)
+	buf, err := p.MarshalBinary()
+	require.Nil(t, err)
+	require.Nil(t, suite.G1().Point().UnmarshalBinary(buf))
+}
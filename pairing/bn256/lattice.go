@@ -0,0 +1,99 @@
+package bn256
+
+import (
+	"math/big"
+)
+
+// lattice is a basis of the vectors (k₀, …, kₘ₋₁) such that Σ kᵢλⁱ ≡ 0 mod
+// Order, where λ is the eigenvalue of an endomorphism of a group of order
+// Order. Multiplying a point by k then amounts to multiplying the images of
+// the point under the powers of the endomorphism by the components of a
+// short vector that decomposes k, which are m times shorter than k.
+type lattice struct {
+	vectors [][]*big.Int
+	inverse []*big.Int // First row of the inverse of the basis, times det
+	det     *big.Int   // Determinant of the basis, which is Order
+}
+
+// curveLattice decomposes scalars of G₁ with the endomorphism (x, y) ↦
+// (βx, y), where β is the cube root of unity xiTo2PSquaredMinus2Over3,
+// whose eigenvalue is λ = 36u³+18u²+6u+1. Its components have 128 bits.
+var curveLattice = &lattice{
+	vectors: [][]*big.Int{
+		{bigFromBase10("-254952053719217182022156415784332439563"), bigFromBase10("-13037178982157583875")},
+		{bigFromBase10("-13037178982157583875"), bigFromBase10("254952053719217182009119236802174855688")},
+	},
+	inverse: []*big.Int{
+		bigFromBase10("-254952053719217182009119236802174855688"),
+		bigFromBase10("-13037178982157583875"),
+	},
+	det: Order,
+}
+
+// twistLattice decomposes scalars of G₂ with the Frobenius endomorphism ψ
+// of the twist, whose eigenvalue is λ = p mod Order. Its components have 64
+// bits.
+var twistLattice = &lattice{
+	vectors: [][]*big.Int{
+		{bigFromBase10("13037178982157583874"), bigFromBase10("6518589491078791938"), bigFromBase10("-6518589491078791937"), bigFromBase10("6518589491078791937")},
+		{bigFromBase10("-6518589491078791937"), bigFromBase10("6518589491078791937"), bigFromBase10("-6518589491078791937"), bigFromBase10("-13037178982157583875")},
+		{bigFromBase10("6518589491078791938"), bigFromBase10("6518589491078791937"), bigFromBase10("6518589491078791937"), bigFromBase10("-13037178982157583874")},
+		{bigFromBase10("13037178982157583875"), bigFromBase10("-6518589491078791937"), bigFromBase10("-6518589491078791938"), bigFromBase10("-6518589491078791937")},
+	},
+	inverse: []*big.Int{
+		bigFromBase10("1661927778103044753460960081172371789225297475402601771781"),
+		bigFromBase10("-1661927778103044753715912134891588971240935301695855419406"),
+		bigFromBase10("1661927778103044753715912134891588971253972480678013003281"),
+		bigFromBase10("1661927778103044753715912134891588971234416712204776627469"),
+	},
+	det: Order,
+}
+
+// decompose returns a short vector (k₀, …, kₘ₋₁) such that Σ kᵢλⁱ ≡ k mod
+// Order, whose components may be negative.
+func (l *lattice) decompose(k *big.Int) []*big.Int {
+	k = new(big.Int).Mod(k, l.det)
+	m := len(l.inverse)
+	half := new(big.Int).Rsh(l.det, 1)
+
+	// Find the closest vector of the lattice to (k, 0, …, 0) with Babai's
+	// rounding, and subtract it.
+	c := make([]*big.Int, m)
+	for i := range c {
+		c[i] = new(big.Int).Mul(k, l.inverse[i])
+		c[i].Add(c[i], half).Div(c[i], l.det)
+	}
+
+	out := make([]*big.Int, m)
+	t := new(big.Int)
+	for i := range out {
+		out[i] = new(big.Int)
+		for j := range c {
+			out[i].Add(out[i], t.Mul(c[j], l.vectors[j][i]))
+		}
+		out[i].Neg(out[i])
+	}
+	out[0].Add(out[0], k)
+	return out
+}
+
+// windows returns, from the most significant one, the indices Σ dᵢ·2^(w·i)
+// of the digits dᵢ of the w-bit windows of the components of k, which must
+// not be negative.
+func windows(k []*big.Int, w int) []int {
+	n := 0
+	for _, ki := range k {
+		if ki.BitLen() > n {
+			n = ki.BitLen()
+		}
+	}
+	idx := make([]int, (n+w-1)/w)
+	for j := range idx {
+		for i, ki := range k {
+			for b := 0; b < w; b++ {
+				idx[len(idx)-1-j] |= int(ki.Bit(j*w+b)) << uint(w*i+b)
+			}
+		}
+	}
+	return idx
+}
@@ -0,0 +1,136 @@
+package bn256
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// testScalars returns edge case and random scalars, including some that are
+// not reduced modulo Order.
+func testScalars() []*big.Int {
+	rand := random.New()
+	k := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(Order, big.NewInt(1)),
+		new(big.Int).Add(Order, big.NewInt(5)),
+		new(big.Int).Lsh(big.NewInt(1), 255),
+		new(big.Int).Set(u),
+	}
+	for i := 0; i < 20; i++ {
+		k = append(k, &mod.NewInt64(0, Order).Pick(rand).(*mod.Int).V)
+	}
+	return k
+}
+
+func TestLatticeDecompose(t *testing.T) {
+	lambda := bigFromBase10("9971566668618268521530616648191882281418254099768607949373")
+	for _, l := range []struct {
+		l      *lattice
+		lambda *big.Int
+		bits   int
+	}{
+		{curveLattice, lambda, 128},
+		{twistLattice, new(big.Int).Mod(p, Order), 65},
+	} {
+		for _, k := range testScalars() {
+			sum, pow := new(big.Int), big.NewInt(1)
+			for _, ki := range l.l.decompose(k) {
+				require.True(t, ki.BitLen() <= l.bits)
+				sum.Add(sum, new(big.Int).Mul(ki, pow))
+				pow.Mul(pow, l.lambda)
+			}
+			require.Equal(t, 0, sum.Sub(sum, k).Mod(sum, Order).Sign())
+		}
+	}
+}
+
+func TestMulGLV(t *testing.T) {
+	a := &curvePoint{}
+	a.Mul(curveGen, &mod.NewInt64(0, Order).Pick(random.New()).(*mod.Int).V)
+	for _, k := range testScalars() {
+		c, d := &curvePoint{}, &curvePoint{}
+		c.Mul(a, k)
+		d.MulGLV(a, k)
+		require.Equal(t, c.String(), d.String(), "scalar %v", k)
+	}
+}
+
+func TestMulGLS(t *testing.T) {
+	a := &twistPoint{}
+	a.Mul(twistGen, &mod.NewInt64(0, Order).Pick(random.New()).(*mod.Int).V)
+
+	// ψ acts as the multiplication by p on G₂.
+	c, d := &twistPoint{}, &twistPoint{}
+	c.Frobenius(a)
+	d.Mul(a, p)
+	require.Equal(t, d.String(), c.String())
+
+	for _, k := range testScalars() {
+		c.Mul(a, k)
+		d.MulGLS(a, k)
+		require.Equal(t, c.String(), d.String(), "scalar %v", k)
+	}
+}
+
+func TestCyclotomicExp(t *testing.T) {
+	a := optimalAte(twistGen, curveGen)
+	a.Exp(a, &mod.NewInt64(0, Order).Pick(random.New()).(*mod.Int).V)
+	require.True(t, a.IsCyclotomic())
+	require.False(t, miller(twistGen, curveGen).IsCyclotomic())
+
+	c, d := &gfP12{}, &gfP12{}
+	require.Equal(t, c.Square(a).String(), d.CyclotomicSquare(a).String())
+	for _, k := range append(testScalars(), big.NewInt(-3)) {
+		c.Exp(a, k)
+		if k.Sign() < 0 {
+			c.Invert(c.Exp(a, new(big.Int).Neg(k)))
+		}
+		d.CyclotomicExp(a, k)
+		require.Equal(t, c.String(), d.String(), "scalar %v", k)
+	}
+}
+
+func BenchmarkG1Mul(b *testing.B) {
+	suite := NewSuite()
+	x := suite.G1().Scalar().Pick(random.New())
+	P := suite.G1().Point().Base()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.Mul(x, P)
+	}
+}
+
+func BenchmarkG2Mul(b *testing.B) {
+	suite := NewSuite()
+	x := suite.G2().Scalar().Pick(random.New())
+	P := suite.G2().Point().Base()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.Mul(x, P)
+	}
+}
+
+func BenchmarkGTMul(b *testing.B) {
+	suite := NewSuite()
+	x := suite.GT().Scalar().Pick(random.New())
+	P := suite.GT().Point().Base()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.Mul(x, P)
+	}
+}
+
+func BenchmarkPair(b *testing.B) {
+	suite := NewSuite()
+	P, Q := suite.G1().Point().Base(), suite.G2().Point().Base()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		suite.Pair(P, Q)
+	}
+}
@@ -117,88 +117,111 @@ var sixuPlus2NAF = []int8{0, 0, 0, 1, 0, 0, 0, 0, 0, 1, 0, 0, 1, 0, 0, 0, -1, 0,
 // miller implements the Miller loop for calculating the Optimal Ate pairing.
 // See algorithm 1 from http://cryptojedi.org/papers/dclxvi-20100714.pdf
 func miller(q *twistPoint, p *curvePoint) *gfP12 {
-	ret := (&gfP12{}).SetOne()
-
-	aAffine := &twistPoint{}
-	aAffine.Set(q)
-	aAffine.MakeAffine()
-
-	bAffine := &curvePoint{}
-	bAffine.Set(p)
-	bAffine.MakeAffine()
+	return multiMiller([]*twistPoint{q}, []*curvePoint{p})
+}
 
-	minusA := &twistPoint{}
-	minusA.Neg(aAffine)
+// millerPair holds the state of the Miller loop for one pair of points.
+type millerPair struct {
+	aAffine, minusA, r *twistPoint
+	bAffine            *curvePoint
+	r2                 *gfP2
+}
 
-	r := &twistPoint{}
-	r.Set(aAffine)
+// multiMiller computes the product of the Miller loops of the pairs
+// (q[i], p[i]) in a single loop, so that the squarings of the result are
+// shared between all pairs. Pairs where either point is at infinity are
+// skipped, since their pairing is one.
+func multiMiller(q []*twistPoint, p []*curvePoint) *gfP12 {
+	ret := (&gfP12{}).SetOne()
 
-	r2 := (&gfP2{}).Square(&aAffine.y)
+	pairs := make([]*millerPair, 0, len(q))
+	for i := range q {
+		if q[i].IsInfinity() || p[i].IsInfinity() {
+			continue
+		}
+		m := &millerPair{
+			aAffine: &twistPoint{},
+			minusA:  &twistPoint{},
+			r:       &twistPoint{},
+			bAffine: &curvePoint{},
+		}
+		m.aAffine.Set(q[i])
+		m.aAffine.MakeAffine()
+		m.bAffine.Set(p[i])
+		m.bAffine.MakeAffine()
+		m.minusA.Neg(m.aAffine)
+		m.r.Set(m.aAffine)
+		m.r2 = (&gfP2{}).Square(&m.aAffine.y)
+		pairs = append(pairs, m)
+	}
 
 	for i := len(sixuPlus2NAF) - 1; i > 0; i-- {
-		a, b, c, newR := lineFunctionDouble(r, bAffine)
 		if i != len(sixuPlus2NAF)-1 {
 			ret.Square(ret)
 		}
 
-		mulLine(ret, a, b, c)
-		r = newR
-
-		switch sixuPlus2NAF[i-1] {
-		case 1:
-			a, b, c, newR = lineFunctionAdd(r, aAffine, bAffine, r2)
-		case -1:
-			a, b, c, newR = lineFunctionAdd(r, minusA, bAffine, r2)
-		default:
-			continue
+		for _, m := range pairs {
+			a, b, c, newR := lineFunctionDouble(m.r, m.bAffine)
+			mulLine(ret, a, b, c)
+			m.r = newR
+
+			switch sixuPlus2NAF[i-1] {
+			case 1:
+				a, b, c, newR = lineFunctionAdd(m.r, m.aAffine, m.bAffine, m.r2)
+			case -1:
+				a, b, c, newR = lineFunctionAdd(m.r, m.minusA, m.bAffine, m.r2)
+			default:
+				continue
+			}
+
+			mulLine(ret, a, b, c)
+			m.r = newR
 		}
+	}
 
+	for _, m := range pairs {
+		// In order to calculate Q1 we have to convert q from the sextic twist
+		// to the full GF(p^12) group, apply the Frobenius there, and convert
+		// back.
+		//
+		// The twist isomorphism is (x', y') -> (xω², yω³). If we consider just
+		// x for a moment, then after applying the Frobenius, we have x̄ω^(2p)
+		// where x̄ is the conjugate of x. If we are going to apply the inverse
+		// isomorphism we need a value with a single coefficient of ω² so we
+		// rewrite this as x̄ω^(2p-2)ω². ξ⁶ = ω and, due to the construction of
+		// p, 2p-2 is a multiple of six. Therefore we can rewrite as
+		// x̄ξ^((p-1)/3)ω² and applying the inverse isomorphism eliminates the
+		// ω².
+		//
+		// A similar argument can be made for the y value.
+
+		q1 := &twistPoint{}
+		q1.x.Conjugate(&m.aAffine.x).Mul(&q1.x, xiToPMinus1Over3)
+		q1.y.Conjugate(&m.aAffine.y).Mul(&q1.y, xiToPMinus1Over2)
+		q1.z.SetOne()
+		q1.t.SetOne()
+
+		// For Q2 we are applying the p² Frobenius. The two conjugations cancel
+		// out and we are left only with the factors from the isomorphism. In
+		// the case of x, we end up with a pure number which is why
+		// xiToPSquaredMinus1Over3 is ∈ GF(p). With y we get a factor of -1. We
+		// ignore this to end up with -Q2.
+
+		minusQ2 := &twistPoint{}
+		minusQ2.x.MulScalar(&m.aAffine.x, xiToPSquaredMinus1Over3)
+		minusQ2.y.Set(&m.aAffine.y)
+		minusQ2.z.SetOne()
+		minusQ2.t.SetOne()
+
+		r2 := (&gfP2{}).Square(&q1.y)
+		a, b, c, newR := lineFunctionAdd(m.r, q1, m.bAffine, r2)
 		mulLine(ret, a, b, c)
-		r = newR
-	}
+		m.r = newR
 
-	// In order to calculate Q1 we have to convert q from the sextic twist
-	// to the full GF(p^12) group, apply the Frobenius there, and convert
-	// back.
-	//
-	// The twist isomorphism is (x', y') -> (xω², yω³). If we consider just
-	// x for a moment, then after applying the Frobenius, we have x̄ω^(2p)
-	// where x̄ is the conjugate of x. If we are going to apply the inverse
-	// isomorphism we need a value with a single coefficient of ω² so we
-	// rewrite this as x̄ω^(2p-2)ω². ξ⁶ = ω and, due to the construction of
-	// p, 2p-2 is a multiple of six. Therefore we can rewrite as
-	// x̄ξ^((p-1)/3)ω² and applying the inverse isomorphism eliminates the
-	// ω².
-	//
-	// A similar argument can be made for the y value.
-
-	q1 := &twistPoint{}
-	q1.x.Conjugate(&aAffine.x).Mul(&q1.x, xiToPMinus1Over3)
-	q1.y.Conjugate(&aAffine.y).Mul(&q1.y, xiToPMinus1Over2)
-	q1.z.SetOne()
-	q1.t.SetOne()
-
-	// For Q2 we are applying the p² Frobenius. The two conjugations cancel
-	// out and we are left only with the factors from the isomorphism. In
-	// the case of x, we end up with a pure number which is why
-	// xiToPSquaredMinus1Over3 is ∈ GF(p). With y we get a factor of -1. We
-	// ignore this to end up with -Q2.
-
-	minusQ2 := &twistPoint{}
-	minusQ2.x.MulScalar(&aAffine.x, xiToPSquaredMinus1Over3)
-	minusQ2.y.Set(&aAffine.y)
-	minusQ2.z.SetOne()
-	minusQ2.t.SetOne()
-
-	r2.Square(&q1.y)
-	a, b, c, newR := lineFunctionAdd(r, q1, bAffine, r2)
-	mulLine(ret, a, b, c)
-	r = newR
-
-	r2.Square(&minusQ2.y)
-	a, b, c, newR = lineFunctionAdd(r, minusQ2, bAffine, r2)
-	mulLine(ret, a, b, c)
-	r = newR
+		r2.Square(&minusQ2.y)
+		a, b, c, _ = lineFunctionAdd(m.r, minusQ2, m.bAffine, r2)
+		mulLine(ret, a, b, c)
+	}
 
 	return ret
 }
@@ -224,9 +247,9 @@ func finalExponentiation(in *gfP12) *gfP12 {
 	fp2 := (&gfP12{}).FrobeniusP2(t1)
 	fp3 := (&gfP12{}).Frobenius(fp2)
 
-	fu := (&gfP12{}).Exp(t1, u)
-	fu2 := (&gfP12{}).Exp(fu, u)
-	fu3 := (&gfP12{}).Exp(fu2, u)
+	fu := (&gfP12{}).CyclotomicExp(t1, u)
+	fu2 := (&gfP12{}).CyclotomicExp(fu, u)
+	fu3 := (&gfP12{}).CyclotomicExp(fu2, u)
 
 	y3 := (&gfP12{}).Frobenius(fu)
 	fu2p := (&gfP12{}).Frobenius(fu2)
@@ -266,3 +289,9 @@ func optimalAte(a *twistPoint, b *curvePoint) *gfP12 {
 	}
 	return ret
 }
+
+// multiPair computes the product of the Optimal Ate pairings of the pairs
+// (a[i], b[i]) with a single Miller loop and a single final exponentiation.
+func multiPair(a []*twistPoint, b []*curvePoint) *gfP12 {
+	return finalExponentiation(multiMiller(a, b))
+}
@@ -5,13 +5,19 @@ import (
 	"crypto/subtle"
 	"errors"
 	"io"
+	"math/big"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/msm"
 )
 
 type pointG1 struct {
-	g *curvePoint
+	g     *curvePoint
+	table *curveTable
+
+	// compressed selects the compressed encoding of the point.
+	compressed bool
 }
 
 func newPointG1() *pointG1 {
@@ -20,8 +26,13 @@ func newPointG1() *pointG1 {
 }
 
 func (p *pointG1) Equal(q kyber.Point) bool {
-	x, _ := p.MarshalBinary()
-	y, _ := q.MarshalBinary()
+	pq, ok := q.(*pointG1)
+	if !ok {
+		return false
+	}
+	// Compare the uncompressed encodings, whatever those of p and q are.
+	x, _ := (&pointG1{g: p.g}).MarshalBinary()
+	y, _ := (&pointG1{g: pq.g}).MarshalBinary()
 	return subtle.ConstantTimeCompare(x, y) == 1
 }
 
@@ -38,25 +49,34 @@ func (p *pointG1) Base() kyber.Point {
 func (p *pointG1) Pick(rand cipher.Stream) kyber.Point {
 	s := mod.NewInt64(0, Order).Pick(rand)
 	p.Base()
-	p.g.Mul(p.g, &s.(*mod.Int).V)
+	p.g.MulGLV(p.g, &s.(*mod.Int).V)
 	return p
 }
 
+// Hash sets p to the hash of msg to G₁ under the domain separation tag dst,
+// following RFC 9380 with the BN256G1_XMD:SHA-256_SVDW_RO_ suite.
+func (p *pointG1) Hash(msg, dst []byte) kyber.Point {
+	p.g.Set(hashToCurve(msg, dst))
+	return p
+}
+
+// HashSuite returns the identifier of the RFC 9380 suite of Hash.
+func (p *pointG1) HashSuite() string {
+	return "BN256G1_XMD:SHA-256_SVDW_RO_"
+}
+
 func (p *pointG1) Set(q kyber.Point) kyber.Point {
 	x := q.(*pointG1).g
 	p.g.Set(x)
+	p.table = q.(*pointG1).table
 	return p
 }
 
 func (p *pointG1) Clone() kyber.Point {
 	q := newPointG1()
-	buf, err := p.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	if err := q.UnmarshalBinary(buf); err != nil {
-		panic(err)
-	}
+	q.g.Set(p.g)
+	q.table = p.table
+	q.compressed = p.compressed
 	return q
 }
 
@@ -102,11 +122,48 @@ func (p *pointG1) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
 	}
 	t := s.(*mod.Int).V
 	r := q.(*pointG1).g
-	p.g.Mul(r, &t)
+	if table := q.(*pointG1).table; table != nil && table.matches(r) && t.BitLen() <= 256 {
+		p.g.MulTable(table, &t)
+	} else {
+		p.g.MulGLV(r, &t)
+	}
 	return p
 }
 
+// Precompute returns a copy of p carrying a table of its multiples, which
+// makes multiplying the copy by a scalar several times faster.
+func (p *pointG1) Precompute() kyber.Point {
+	q := p.Clone().(*pointG1)
+	q.table = newCurveTable(q.g)
+	return q
+}
+
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, in variable time.
+func (p *pointG1) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	return msm.MultiMulInt(p, scalarInts(scalars), points)
+}
+
+// Valid reports whether p is on the curve, which is the whole of G₁.
+func (p *pointG1) Valid() bool {
+	c := *p.g
+	return c.IsOnCurve()
+}
+
+// IsSmallOrder reports whether p is the point at infinity.
+func (p *pointG1) IsSmallOrder() bool {
+	return p.g.IsInfinity()
+}
+
+// ClearCofactor sets p to q, since G₁ is the whole curve.
+func (p *pointG1) ClearCofactor(q kyber.Point) kyber.Point {
+	return p.Set(q)
+}
+
 func (p *pointG1) MarshalBinary() ([]byte, error) {
+	if p.compressed {
+		return p.marshalCompressed(), nil
+	}
 	n := p.ElementSize()
 	p.g.MakeAffine()
 	ret := make([]byte, p.MarshalSize())
@@ -130,6 +187,9 @@ func (p *pointG1) MarshalTo(w io.Writer) (int, error) {
 }
 
 func (p *pointG1) UnmarshalBinary(buf []byte) error {
+	if p.compressed {
+		return p.unmarshalCompressed(buf)
+	}
 	n := p.ElementSize()
 	if len(buf) < p.MarshalSize() {
 		return errors.New("bn256.G1: not enough data")
@@ -173,6 +233,9 @@ func (p *pointG1) UnmarshalFrom(r io.Reader) (int, error) {
 }
 
 func (p *pointG1) MarshalSize() int {
+	if p.compressed {
+		return 1 + p.ElementSize()
+	}
 	return 2 * p.ElementSize()
 }
 
@@ -184,8 +247,21 @@ func (p *pointG1) String() string {
 	return "bn256.G1" + p.g.String()
 }
 
+// scalarInts returns the integer values of the given scalars.
+func scalarInts(scalars []kyber.Scalar) []*big.Int {
+	v := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		v[i] = &s.(*mod.Int).V
+	}
+	return v
+}
+
 type pointG2 struct {
-	g *twistPoint
+	g     *twistPoint
+	table *twistTable
+
+	// compressed selects the compressed encoding of the point.
+	compressed bool
 }
 
 func newPointG2() *pointG2 {
@@ -194,8 +270,13 @@ func newPointG2() *pointG2 {
 }
 
 func (p *pointG2) Equal(q kyber.Point) bool {
-	x, _ := p.MarshalBinary()
-	y, _ := q.MarshalBinary()
+	pq, ok := q.(*pointG2)
+	if !ok {
+		return false
+	}
+	// Compare the uncompressed encodings, whatever those of p and q are.
+	x, _ := (&pointG2{g: p.g}).MarshalBinary()
+	y, _ := (&pointG2{g: pq.g}).MarshalBinary()
 	return subtle.ConstantTimeCompare(x, y) == 1
 }
 
@@ -212,25 +293,34 @@ func (p *pointG2) Base() kyber.Point {
 func (p *pointG2) Pick(rand cipher.Stream) kyber.Point {
 	s := mod.NewInt64(0, Order).Pick(rand)
 	p.Base()
-	p.g.Mul(p.g, &s.(*mod.Int).V)
+	p.g.MulGLS(p.g, &s.(*mod.Int).V)
+	return p
+}
+
+// Hash sets p to the hash of msg to G₂ under the domain separation tag dst,
+// following RFC 9380 with the BN256G2_XMD:SHA-256_SVDW_RO_ suite.
+func (p *pointG2) Hash(msg, dst []byte) kyber.Point {
+	p.g.Set(hashToTwist(msg, dst))
 	return p
 }
 
+// HashSuite returns the identifier of the RFC 9380 suite of Hash.
+func (p *pointG2) HashSuite() string {
+	return "BN256G2_XMD:SHA-256_SVDW_RO_"
+}
+
 func (p *pointG2) Set(q kyber.Point) kyber.Point {
 	x := q.(*pointG2).g
 	p.g.Set(x)
+	p.table = q.(*pointG2).table
 	return p
 }
 
 func (p *pointG2) Clone() kyber.Point {
 	q := newPointG2()
-	buf, err := p.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	if err := q.UnmarshalBinary(buf); err != nil {
-		panic(err)
-	}
+	q.g.Set(p.g)
+	q.table = p.table
+	q.compressed = p.compressed
 	return q
 }
 
@@ -270,11 +360,58 @@ func (p *pointG2) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
 	}
 	t := s.(*mod.Int).V
 	r := q.(*pointG2).g
-	p.g.Mul(r, &t)
+	if table := q.(*pointG2).table; table != nil && table.matches(r) && t.BitLen() <= 256 {
+		p.g.MulTable(table, &t)
+	} else {
+		p.g.MulGLS(r, &t)
+	}
+	return p
+}
+
+// Precompute returns a copy of p carrying a table of its multiples, which
+// makes multiplying the copy by a scalar several times faster.
+func (p *pointG2) Precompute() kyber.Point {
+	q := p.Clone().(*pointG2)
+	q.table = newTwistTable(q.g)
+	return q
+}
+
+// MultiMul sets p to the sum of the points multiplied by the scalars, where a
+// nil point stands for the base point, in variable time.
+func (p *pointG2) MultiMul(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	return msm.MultiMulInt(p, scalarInts(scalars), points)
+}
+
+// Valid reports whether p is on the twist and in G₂.
+func (p *pointG2) Valid() bool {
+	c := *p.g
+	if !c.IsOnCurve() {
+		return false
+	}
+	t := &twistPoint{}
+	t.Mul(&c, Order)
+	return t.IsInfinity()
+}
+
+// IsSmallOrder reports whether the order of p divides the cofactor of G₂ in
+// the twist.
+func (p *pointG2) IsSmallOrder() bool {
+	t := &twistPoint{}
+	t.Mul(p.g, twistCofactor)
+	return t.IsInfinity()
+}
+
+// ClearCofactor sets p to the multiple of q by the cofactor of G₂ in the
+// twist.
+func (p *pointG2) ClearCofactor(q kyber.Point) kyber.Point {
+	p.g.Mul(q.(*pointG2).g, twistCofactor)
 	return p
 }
 
 func (p *pointG2) MarshalBinary() ([]byte, error) {
+	if p.compressed {
+		return p.marshalCompressed(), nil
+	}
 	n := p.ElementSize()
 	if p.g == nil {
 		p.g = &twistPoint{}
@@ -310,6 +447,9 @@ func (p *pointG2) MarshalTo(w io.Writer) (int, error) {
 }
 
 func (p *pointG2) UnmarshalBinary(buf []byte) error {
+	if p.compressed {
+		return p.unmarshalCompressed(buf)
+	}
 	n := p.ElementSize()
 	if p.g == nil {
 		p.g = &twistPoint{}
@@ -325,6 +465,9 @@ func (p *pointG2) UnmarshalBinary(buf []byte) error {
 		return errors.New("bn256.G2: not enough data")
 	}
 
+	// Unmarshal adds to the existing value.
+	p.g.x.SetZero()
+	p.g.y.SetZero()
 	p.g.x.x.Unmarshal(buf[1+0*n:])
 	p.g.x.y.Unmarshal(buf[1+1*n:])
 	p.g.y.x.Unmarshal(buf[1+2*n:])
@@ -346,6 +489,13 @@ func (p *pointG2) UnmarshalBinary(buf []byte) error {
 		if !p.g.IsOnCurve() {
 			return errors.New("bn256.G2: malformed point")
 		}
+		// Like in the compressed form, reject the points of the twist
+		// that are not in G₂.
+		t := &twistPoint{}
+		t.Mul(p.g, Order)
+		if !t.IsInfinity() {
+			return errors.New("bn256.G2: point is not in G2")
+		}
 	}
 	return nil
 }
@@ -360,6 +510,9 @@ func (p *pointG2) UnmarshalFrom(r io.Reader) (int, error) {
 }
 
 func (p *pointG2) MarshalSize() int {
+	if p.compressed {
+		return 1 + 2*p.ElementSize()
+	}
 	return 4*p.ElementSize() + 1
 }
 
@@ -399,7 +552,7 @@ func (p *pointGT) Base() kyber.Point {
 func (p *pointGT) Pick(rand cipher.Stream) kyber.Point {
 	s := mod.NewInt64(0, Order).Pick(rand)
 	p.Base()
-	p.g.Exp(p.g, &s.(*mod.Int).V)
+	p.g.CyclotomicExp(p.g, &s.(*mod.Int).V)
 	return p
 }
 
@@ -457,7 +610,31 @@ func (p *pointGT) Mul(s kyber.Scalar, q kyber.Point) kyber.Point {
 	}
 	t := s.(*mod.Int).V
 	r := q.(*pointGT).g
-	p.g.Exp(r, &t)
+	// Unfinalized Miller loop results lie outside the cyclotomic subgroup.
+	if r.IsCyclotomic() {
+		p.g.CyclotomicExp(r, &t)
+	} else {
+		p.g.Exp(r, &t)
+	}
+	return p
+}
+
+// Valid reports whether p is an element of GT, that is whether its order
+// divides n.
+func (p *pointGT) Valid() bool {
+	return (&gfP12{}).Exp(p.g, Order).IsOne()
+}
+
+// IsSmallOrder reports whether the order of p divides the cofactor of GT in
+// the multiplicative group of GF(p¹²).
+func (p *pointGT) IsSmallOrder() bool {
+	return (&gfP12{}).Exp(p.g, gtCofactor).IsOne()
+}
+
+// ClearCofactor sets p to q raised to the cofactor of GT in the
+// multiplicative group of GF(p¹²).
+func (p *pointGT) ClearCofactor(q kyber.Point) kyber.Point {
+	p.g.Exp(q.(*pointGT).g, gtCofactor)
 	return p
 }
 
@@ -582,3 +759,20 @@ func (p *pointGT) Pair(p1, p2 kyber.Point) kyber.Point {
 	p.g.Set(optimalAte(b, a))
 	return p
 }
+
+// MultiPair sets p to the product of the pairings of the points of p1s in G₁
+// with the points of p2s in G₂, computed with a single Miller loop and a
+// single final exponentiation.
+func (p *pointGT) MultiPair(p1s, p2s []kyber.Point) kyber.Point {
+	if len(p1s) != len(p2s) {
+		panic("bn256: mismatched number of points")
+	}
+	a := make([]*curvePoint, len(p1s))
+	b := make([]*twistPoint, len(p2s))
+	for i := range p1s {
+		a[i] = p1s[i].(*pointG1).g
+		b[i] = p2s[i].(*pointG2).g
+	}
+	p.g.Set(multiPair(b, a))
+	return p
+}
@@ -42,6 +42,17 @@ func NewSuiteRand(rand cipher.Stream) *Suite {
 	return s
 }
 
+// NewSuiteCompressed generates and returns a new BN256 pairing suite whose
+// points of G1 and G2 are marshaled in compressed form, as their
+// x-coordinate and the sign of their y-coordinate. This halves the size of
+// the encodings at the cost of a square root to unmarshal them.
+func NewSuiteCompressed() *Suite {
+	s := NewSuite()
+	s.g1.compressed = true
+	s.g2.compressed = true
+	return s
+}
+
 // G1 returns the group G1 of the BN256 pairing.
 func (s *Suite) G1() kyber.Group {
 	return s.g1
@@ -63,6 +74,20 @@ func (s *Suite) Pair(p1 kyber.Point, p2 kyber.Point) kyber.Point {
 	return s.GT().Point().(*pointGT).Pair(p1, p2)
 }
 
+// MultiPair takes the points of p1s and p2s in groups G1 and G2,
+// respectively, as input and computes the product of their pairings in GT.
+// This is much faster than computing each pairing separately.
+func (s *Suite) MultiPair(p1s, p2s []kyber.Point) kyber.Point {
+	return s.GT().Point().(*pointGT).MultiPair(p1s, p2s)
+}
+
+// PairingCheck takes the points of p1s and p2s in groups G1 and G2,
+// respectively, as input and returns true iff the product of their pairings
+// is the identity of GT.
+func (s *Suite) PairingCheck(p1s, p2s []kyber.Point) bool {
+	return s.GT().Point().(*pointGT).MultiPair(p1s, p2s).(*pointGT).g.IsOne()
+}
+
 // Hash returns a newly instantiated sha256 hash function.
 func (s *Suite) Hash() hash.Hash {
 	return sha256.New()
@@ -1,8 +1,10 @@
 package bn256
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/mod"
 	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/require"
@@ -127,7 +129,7 @@ func TestG2Marshal(t *testing.T) {
 	pa := suite.G2().Point().Mul(k, nil)
 	ma, err := pa.MarshalBinary()
 	require.Nil(t, err)
-	pb := suite.G2().Point()
+	pb := suite.G2().Point().Pick(random.New())
 	err = pb.UnmarshalBinary(ma)
 	require.Nil(t, err)
 	mb, err := pb.MarshalBinary()
@@ -135,6 +137,64 @@ func TestG2Marshal(t *testing.T) {
 	require.Equal(t, ma, mb)
 }
 
+func TestCompressedMarshal(t *testing.T) {
+	suite := NewSuiteCompressed()
+	require.Equal(t, 33, suite.G1().PointLen())
+	require.Equal(t, 65, suite.G2().PointLen())
+	points := []kyber.Point{
+		suite.G1().Point().Pick(random.New()),
+		suite.G1().Point().Null(),
+		suite.G2().Point().Pick(random.New()),
+		suite.G2().Point().Null(),
+	}
+	for _, pa := range points {
+		ma, err := pa.MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, pa.MarshalSize(), len(ma))
+		pb := pa.Clone()
+		pb.Pick(random.New())
+		require.Nil(t, pb.UnmarshalBinary(ma))
+		require.True(t, pa.Equal(pb))
+
+		// The opposite point only differs by its leading byte.
+		mn, err := pa.Clone().Neg(pa).MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, ma[1:], mn[1:])
+
+		bad := append([]byte{}, ma...)
+		bad[0] = 0x04
+		require.NotNil(t, pb.UnmarshalBinary(bad))
+		require.NotNil(t, pb.UnmarshalBinary(ma[1:]))
+	}
+
+	// Compressed and uncompressed points are equal and interoperate.
+	pa := NewSuite().G1().Point().Pick(random.New())
+	pb := suite.G1().Point().Set(pa)
+	require.True(t, pa.Equal(pb))
+	require.True(t, pb.Equal(pa))
+
+	// Points of different groups are never equal.
+	require.False(t, pa.Equal(suite.G2().Point().Null()))
+	require.False(t, suite.G2().Point().Null().Equal(pa))
+	ma, err := pb.MarshalBinary()
+	require.Nil(t, err)
+	pc := suite.G1().Point()
+	require.Nil(t, suite.Read(bytes.NewReader(ma), pc))
+	require.True(t, pa.Equal(pc))
+
+	// x = 2 is not the x-coordinate of a point of G₁, since 11 is not a
+	// square modulo p.
+	bad := make([]byte, 33)
+	bad[0], bad[32] = 0x02, 2
+	require.NotNil(t, suite.G1().Point().UnmarshalBinary(bad))
+
+	// Points of the twist outside of G₂ are rejected.
+	q := &pointG2{g: mapToTwist(&gfP2{*newGFp(1), *newGFp(2)}), compressed: true}
+	ma, err = q.MarshalBinary()
+	require.Nil(t, err)
+	require.NotNil(t, suite.G2().Point().UnmarshalBinary(ma))
+}
+
 func TestG2Ops(t *testing.T) {
 	suite := NewSuite()
 	a := suite.G2().Point().Pick(random.New())
@@ -156,6 +216,45 @@ func TestG2Ops(t *testing.T) {
 	}
 }
 
+func TestMultiMul(t *testing.T) {
+	suite := NewSuite()
+	for _, g := range []kyber.Group{suite.G1(), suite.G2()} {
+		scalars := make([]kyber.Scalar, 10)
+		points := make([]kyber.Point, 10)
+		sum := g.Point().Null()
+		for i := range points {
+			scalars[i] = g.Scalar().Pick(random.New())
+			if i > 0 {
+				points[i] = g.Point().Pick(random.New())
+			}
+			sum.Add(sum, g.Point().Mul(scalars[i], points[i]))
+		}
+		p := g.Point().(kyber.MultiScalarMul).MultiMul(scalars, points)
+		require.True(t, p.Equal(sum))
+	}
+}
+
+func TestPrecompute(t *testing.T) {
+	suite := NewSuite()
+	for _, g := range []kyber.Group{suite.G1(), suite.G2()} {
+		p := g.Point().Pick(random.New())
+		pre := p.(kyber.Precomputable).Precompute()
+		for i := 0; i < 5; i++ {
+			s := g.Scalar().Pick(random.New())
+			require.True(t, g.Point().Mul(s, pre).Equal(g.Point().Mul(s, p)))
+		}
+		s := g.Scalar().Zero()
+		require.True(t, g.Point().Mul(s, pre).Equal(g.Point().Null()))
+		s.SetInt64(-1)
+		require.True(t, g.Point().Mul(s, pre).Equal(g.Point().Neg(p)))
+
+		pre.Add(pre, p)
+		p.Add(p, p)
+		s.Pick(random.New())
+		require.True(t, g.Point().Mul(s, pre).Equal(g.Point().Mul(s, p)))
+	}
+}
+
 func TestGT(t *testing.T) {
 	suite := NewSuite()
 	k := suite.GT().Scalar().Pick(random.New())
@@ -221,6 +320,35 @@ func TestBilinearity(t *testing.T) {
 	require.Equal(t, pc, pd)
 }
 
+func TestMultiPair(t *testing.T) {
+	suite := NewSuite()
+	rand := random.New()
+	p1s := make([]kyber.Point, 3)
+	p2s := make([]kyber.Point, 3)
+	prod := suite.GT().Point().Null()
+	for i := range p1s {
+		p1s[i] = suite.G1().Point().Pick(rand)
+		p2s[i] = suite.G2().Point().Pick(rand)
+		prod.Add(prod, suite.Pair(p1s[i], p2s[i]))
+	}
+	// Pairs with a point at infinity do not change the product.
+	p1s = append(p1s, suite.G1().Point().Null(), suite.G1().Point().Pick(rand))
+	p2s = append(p2s, suite.G2().Point().Pick(rand), suite.G2().Point().Null())
+	require.True(t, prod.Equal(suite.MultiPair(p1s, p2s)))
+	require.False(t, suite.PairingCheck(p1s, p2s))
+
+	// e(aP, Q) * e(-P, aQ) = 1
+	a := suite.G1().Scalar().Pick(rand)
+	p := suite.G1().Point().Pick(rand)
+	q := suite.G2().Point().Pick(rand)
+	ap := suite.G1().Point().Mul(a, p)
+	aq := suite.G2().Point().Mul(a, q)
+	minusP := suite.G1().Point().Neg(p)
+	require.True(t, suite.PairingCheck([]kyber.Point{ap, minusP}, []kyber.Point{q, aq}))
+	require.False(t, suite.PairingCheck([]kyber.Point{ap, p}, []kyber.Point{q, aq}))
+	require.True(t, suite.PairingCheck(nil, nil))
+}
+
 func TestTripartiteDiffieHellman(t *testing.T) {
 	suite := NewSuite()
 	a := suite.G1().Scalar().Pick(random.New())
@@ -0,0 +1,236 @@
+#!/usr/bin/env python3
+"""Generates the known answers of hash_test.go.
+
+This is an independent implementation of the BN256G1_XMD:SHA-256_SVDW_RO_
+and BN256G2_XMD:SHA-256_SVDW_RO_ suites of package bn256, written directly
+from RFC 9380 (https://www.rfc-editor.org/rfc/rfc9380) with plain integers:
+
+  - expand_message_xmd with SHA-256 (section 5.3.1) and hash_to_field with
+    L = 48 (section 5.2),
+  - the Shallue-van de Woestijne map (section 6.6.1), in the straight-line
+    form of appendix F.1, with the constants c1..c4 computed from Z = 1 as
+    listed there,
+  - sgn0 for m = 1 and m = 2 (section 4.1),
+  - affine point arithmetic on y^2 = x^3 + 3 over GF(p) and on the twist
+    y^2 = x^3 + 3/xi over GF(p^2) = GF(p)[i]/(i^2 + 1), with xi = i + 3.
+
+G1 is the whole curve. Points of the twist are multiplied by 2p - n to
+clear the cofactor of G2.
+
+Run it with python3; it only needs the standard library.
+"""
+
+import hashlib
+
+P = 65000549695646603732796438742359905742825358107623003571877145026864184071783
+N = 65000549695646603732796438742359905742570406053903786389881062969044166799969
+L = 48
+
+
+def expand_message_xmd(msg, dst, length):
+    b_len, s_len = 32, 64
+    ell = (length + b_len - 1) // b_len
+    assert ell <= 255 and len(dst) <= 255
+    dst_prime = dst + bytes([len(dst)])
+    b0 = hashlib.sha256(bytes(s_len) + msg + length.to_bytes(2, "big") +
+                        b"\x00" + dst_prime).digest()
+    b = [hashlib.sha256(b0 + b"\x01" + dst_prime).digest()]
+    for i in range(2, ell + 1):
+        x = bytes(u ^ v for u, v in zip(b0, b[-1]))
+        b.append(hashlib.sha256(x + bytes([i]) + dst_prime).digest())
+    return b"".join(b)[:length]
+
+
+def hash_to_field(msg, dst, count, m):
+    uniform = expand_message_xmd(msg, dst, count * m * L)
+    e = [int.from_bytes(uniform[L * i:L * (i + 1)], "big") % P
+         for i in range(count * m)]
+    return [e[m * i:m * (i + 1)] for i in range(count)]
+
+
+class Fp:
+    """An element of GF(p)."""
+
+    def __init__(self, a):
+        self.a = a % P
+
+    def __add__(self, o): return Fp(self.a + o.a)
+    def __sub__(self, o): return Fp(self.a - o.a)
+    def __mul__(self, o): return Fp(self.a * o.a)
+    def __neg__(self): return Fp(-self.a)
+    def __eq__(self, o): return self.a == o.a
+    def __pow__(self, e): return Fp(pow(self.a, e, P))
+    def inv0(self): return self ** (P - 2)
+    def is_zero(self): return self.a == 0
+    def is_square(self): return self ** ((P - 1) // 2) != Fp(P - 1)
+    def sgn0(self): return self.a % 2
+
+    def sqrt(self):
+        r = self ** ((P + 1) // 4)
+        assert r * r == self
+        return r
+
+    def one(self): return Fp(1)
+    def coords(self): return [self.a]
+
+
+class Fp2:
+    """An element c0 + c1*i of GF(p^2), with i^2 = -1."""
+
+    def __init__(self, c0, c1):
+        self.c0, self.c1 = c0 % P, c1 % P
+
+    def __add__(self, o): return Fp2(self.c0 + o.c0, self.c1 + o.c1)
+    def __sub__(self, o): return Fp2(self.c0 - o.c0, self.c1 - o.c1)
+    def __neg__(self): return Fp2(-self.c0, -self.c1)
+    def __eq__(self, o): return (self.c0, self.c1) == (o.c0, o.c1)
+
+    def __mul__(self, o):
+        return Fp2(self.c0 * o.c0 - self.c1 * o.c1,
+                   self.c0 * o.c1 + self.c1 * o.c0)
+
+    def __pow__(self, e):
+        r, b = Fp2(1, 0), self
+        while e:
+            if e & 1:
+                r = r * b
+            b, e = b * b, e >> 1
+        return r
+
+    def norm(self): return Fp(self.c0 * self.c0 + self.c1 * self.c1)
+    def is_zero(self): return self.c0 == 0 and self.c1 == 0
+    def is_square(self): return self.norm().is_square()
+
+    def inv0(self):
+        n = self.norm().inv0().a
+        return Fp2(self.c0 * n, -self.c1 * n)
+
+    def sgn0(self):
+        return (self.c0 % 2) | ((self.c0 == 0) & (self.c1 % 2))
+
+    def sqrt(self):
+        # Any square root will do, since the map fixes the sign of y.
+        if self.is_zero():
+            return self
+        a = self.norm().sqrt()
+        for n in (a, -a):
+            d = (Fp(self.c0) + n) * Fp((P + 1) // 2)
+            if d.is_square():
+                x0 = d.sqrt()
+                if x0.is_zero():
+                    x1 = (-Fp(self.c0)).sqrt()
+                else:
+                    x1 = Fp(self.c1) * (x0 + x0).inv0()
+                r = Fp2(x0.a, x1.a)
+                assert r * r == self
+                return r
+        raise ValueError("not a square")
+
+    def one(self): return Fp2(1, 0)
+    def coords(self): return [self.c1, self.c0]
+
+
+class Curve:
+    """The curve y^2 = x^3 + b over a field, with the SVDW map for Z."""
+
+    def __init__(self, b, z):
+        self.b = b
+        self.z = z
+        gz = self.g(z)
+        three = z.one() + z.one() + z.one()
+        two_inv = (z.one() + z.one()).inv0()
+        # Appendix F.1: c1 = g(Z), c2 = -Z/2, c3 = sqrt(-g(Z)*(3Z^2)) with
+        # sgn0(c3) = 0, and c4 = -4g(Z)/(3Z^2).
+        self.c1 = gz
+        self.c2 = -(z * two_inv)
+        c3 = (-(gz * three * z * z)).sqrt()
+        self.c3 = -c3 if c3.sgn0() == 1 else c3
+        four = three + z.one()
+        self.c4 = -(four * gz) * (three * z * z).inv0()
+
+    def g(self, x):
+        return x * x * x + self.b
+
+    def map_to_curve(self, u):
+        one = u.one()
+        tv1 = u * u * self.c1
+        tv2 = one + tv1
+        tv1 = one - tv1
+        tv3 = (tv1 * tv2).inv0()
+        tv4 = u * tv1 * tv3 * self.c3
+        x1 = self.c2 - tv4
+        x2 = self.c2 + tv4
+        x3 = tv2 * tv2 * tv3
+        x3 = x3 * x3 * self.c4 + self.z
+        if self.g(x1).is_square():
+            x = x1
+        elif self.g(x2).is_square():
+            x = x2
+        else:
+            x = x3
+        y = self.g(x).sqrt()
+        if u.sgn0() != y.sgn0():
+            y = -y
+        return (x, y)
+
+    def add(self, p, q):
+        if p is None:
+            return q
+        if q is None:
+            return p
+        (x1, y1), (x2, y2) = p, q
+        if x1 == x2:
+            if (y1 + y2).is_zero():
+                return None
+            three = x1.one() + x1.one() + x1.one()
+            lam = three * x1 * x1 * (y1 + y1).inv0()
+        else:
+            lam = (y2 - y1) * (x2 - x1).inv0()
+        x3 = lam * lam - x1 - x2
+        return (x3, lam * (x1 - x3) - y1)
+
+    def mul(self, k, p):
+        r = None
+        for bit in bin(k)[2:]:
+            r = self.add(r, r)
+            if bit == "1":
+                r = self.add(r, p)
+        return r
+
+
+XI = Fp2(3, 1)
+G1 = Curve(Fp(3), Fp(1))
+G2 = Curve(Fp2(3, 0) * XI.inv0(), Fp2(1, 0))
+
+
+def hash_to_g1(msg, dst):
+    u0, u1 = hash_to_field(msg, dst, 2, 1)
+    return G1.add(G1.map_to_curve(Fp(u0[0])), G1.map_to_curve(Fp(u1[0])))
+
+
+def hash_to_g2(msg, dst):
+    u0, u1 = hash_to_field(msg, dst, 2, 2)
+    q = G2.add(G2.map_to_curve(Fp2(*u0)), G2.map_to_curve(Fp2(*u1)))
+    return G2.mul(2 * P - N, q)
+
+
+def hexcoords(p):
+    return [format(c, "064x") for e in p for c in e.coords()]
+
+
+def main():
+    for msg in [b"", b"abc"]:
+        out = expand_message_xmd(msg, b"QUUX-V01-CS02-with-expander-SHA256-128", 0x20)
+        print("expand_message_xmd(%r) = %s" % (msg, out.hex()))
+
+    dst = b"QUUX-V01-CS02-with-BN256G1_XMD:SHA-256_SVDW_RO_"
+    for msg in [b"", b"abc", b"abcdef0123456789"]:
+        print("G1(%r): x, y =" % msg, *hexcoords(hash_to_g1(msg, dst)))
+
+    dst = b"QUUX-V01-CS02-with-BN256G2_XMD:SHA-256_SVDW_RO_"
+    for msg in [b"", b"abc"]:
+        print("G2(%r): x1, x0, y1, y0 =" % msg, *hexcoords(hash_to_g2(msg, dst)))
+
+
+if __name__ == "__main__":
+    main()
@@ -129,11 +129,16 @@ func (c *twistPoint) Add(a, b *twistPoint) {
 
 func (c *twistPoint) Double(a *twistPoint) {
 	// See http://hyperelliptic.org/EFD/g1p/auto-code/shortw/jacobian-0/doubling/dbl-2009-l.op3
+	// z is computed first, as a.z is not needed afterwards, so that c may
+	// alias a.
+	t := (&gfP2{}).Mul(&a.y, &a.z)
+	c.z.Add(t, t)
+
 	A := (&gfP2{}).Square(&a.x)
 	B := (&gfP2{}).Square(&a.y)
 	C := (&gfP2{}).Square(B)
 
-	t := (&gfP2{}).Add(&a.x, B)
+	t.Add(&a.x, B)
 	t2 := (&gfP2{}).Square(t)
 	t.Sub(t2, A)
 	t2.Sub(t, C)
@@ -151,9 +156,6 @@ func (c *twistPoint) Double(a *twistPoint) {
 	c.y.Sub(d, &c.x)
 	t2.Mul(e, &c.y)
 	c.y.Sub(t2, t)
-
-	t.Mul(&a.y, &a.z)
-	c.z.Add(t, t)
 }
 
 func (c *twistPoint) Mul(a *twistPoint, scalar *big.Int) {
@@ -171,6 +173,109 @@ func (c *twistPoint) Mul(a *twistPoint, scalar *big.Int) {
 	c.Set(sum)
 }
 
+// Frobenius sets c to ψ(a), where ψ is the endomorphism of the twist
+// derived from the p-power Frobenius of GF(p¹²), which acts on G₂ as the
+// multiplication by p.
+func (c *twistPoint) Frobenius(a *twistPoint) {
+	c.x.Conjugate(&a.x).Mul(&c.x, xiToPMinus1Over3)
+	c.y.Conjugate(&a.y).Mul(&c.y, xiToPMinus1Over2)
+	c.z.Conjugate(&a.z)
+	c.t.Conjugate(&a.t)
+}
+
+// MulGLS sets c = scalar·a like Mul, several times faster, by splitting the
+// scalar into four quarters with the GLS method: a must be in G₂, so that
+// ψ(a) is p·a.
+func (c *twistPoint) MulGLS(a *twistPoint, scalar *big.Int) {
+	k := twistLattice.decompose(scalar)
+
+	var base [4]twistPoint
+	base[0].Set(a)
+	for i := 1; i < len(base); i++ {
+		base[i].Frobenius(&base[i-1])
+	}
+	for i := range k {
+		if k[i].Sign() < 0 {
+			k[i].Neg(k[i])
+			base[i].Neg(&base[i])
+		}
+	}
+
+	// precomp[Σ 2^i·dᵢ] = Σ dᵢ·base[i] for the bits dᵢ of the components.
+	var precomp [16]twistPoint
+	precomp[0].SetInfinity()
+	for i := 1; i < len(precomp); i++ {
+		low := i & -i
+		j := 0
+		for low>>uint(j) != 1 {
+			j++
+		}
+		precomp[i].Add(&precomp[i-low], &base[j])
+	}
+
+	sum, t := &twistPoint{}, &twistPoint{}
+	sum.SetInfinity()
+	for _, w := range windows(k, 1) {
+		t.Double(sum)
+		if w != 0 {
+			sum.Add(t, &precomp[w])
+		} else {
+			sum.Set(t)
+		}
+	}
+
+	c.Set(sum)
+}
+
+// twistTable holds the multiples d·16^i·a of an affine point a, for
+// 1 ≤ d ≤ 8 and 0 ≤ i ≤ 64, which let MulTable replace the doublings of Mul
+// by table lookups.
+type twistTable struct {
+	a         twistPoint
+	multiples [65][8]twistPoint
+}
+
+func newTwistTable(a *twistPoint) *twistTable {
+	t := &twistTable{}
+	t.a.Set(a)
+	t.a.MakeAffine()
+
+	ai := &twistPoint{}
+	ai.Set(&t.a)
+	for i := range t.multiples {
+		m := &t.multiples[i]
+		m[0].Set(ai)
+		for j := 1; j < len(m); j++ {
+			m[j].Add(&m[j-1], ai)
+		}
+		ai.Double(&m[7])
+	}
+	return t
+}
+
+// matches returns true iff the table was computed for a.
+func (t *twistTable) matches(a *twistPoint) bool {
+	return t.a.x == a.x && t.a.y == a.y && t.a.z == a.z
+}
+
+// MulTable sets c = scalar·a, where t is the table of a and scalar is
+// smaller than 2^256.
+func (c *twistPoint) MulTable(t *twistTable, scalar *big.Int) {
+	sum, neg := &twistPoint{}, &twistPoint{}
+	sum.SetInfinity()
+
+	for i, d := range signedDigits(scalar) {
+		if d > 0 {
+			sum.Add(sum, &t.multiples[i][d-1])
+		} else if d < 0 {
+			neg.Neg(&t.multiples[i][-d-1])
+			sum.Add(sum, neg)
+		}
+	}
+
+	c.Set(sum)
+}
+
 func (c *twistPoint) MakeAffine() {
 	if c.z.IsOne() {
 		return
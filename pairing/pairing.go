@@ -10,6 +10,12 @@ type Suite interface {
 	G2() kyber.Group
 	GT() kyber.Group
 	Pair(p1, p2 kyber.Point) kyber.Point
+	// MultiPair returns the product of the pairings of the points of p1s
+	// in G₁ with the points of p2s in G₂, sharing the work between them.
+	MultiPair(p1s, p2s []kyber.Point) kyber.Point
+	// PairingCheck returns true iff the product of the pairings of the
+	// points of p1s in G₁ with the points of p2s in G₂ is the identity.
+	PairingCheck(p1s, p2s []kyber.Point) bool
 	kyber.Encoding
 	kyber.HashFactory
 	kyber.XOFFactory
@@ -9,6 +9,7 @@ import (
 	"errors"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/msm"
 )
 
 // Suite wraps the functionalities needed by the dleq package.
@@ -121,12 +122,9 @@ func NewDLEQProofBatch(suite Suite, G []kyber.Point, H []kyber.Point, secrets []
 //   vG == rG + c(xG)
 //   vH == rH + c(xH)
 func (p *Proof) Verify(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point, xH kyber.Point) error {
-	rG := suite.Point().Mul(p.R, G)
-	rH := suite.Point().Mul(p.R, H)
-	cxG := suite.Point().Mul(p.C, xG)
-	cxH := suite.Point().Mul(p.C, xH)
-	a := suite.Point().Add(rG, cxG)
-	b := suite.Point().Add(rH, cxH)
+	rc := []kyber.Scalar{p.R, p.C}
+	a := msm.MultiMul(suite.Point(), rc, []kyber.Point{G, xG})
+	b := msm.MultiMul(suite.Point(), rc, []kyber.Point{H, xH})
 	if !(p.VG.Equal(a) && p.VH.Equal(b)) {
 		return errorInvalidProof
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/dedis/kyber/share"
 	vss "github.com/dedis/kyber/share/vss/pedersen"
+	"github.com/dedis/kyber/util/msm"
 )
 
 // Suite wraps the functionalities needed by the dkg package
@@ -101,6 +102,9 @@ func NewDistKeyGenerator(suite Suite, longterm kyber.Scalar, participants []kybe
 	if !found {
 		return nil, errors.New("dkg: own public key not found in list of participants")
 	}
+	// The public keys are used to verify the signatures on the responses to
+	// every deal, so their tables are computed once for all verifiers.
+	participants = precompute(participants)
 	var err error
 	// generate our dealer / deal
 	ownSec := suite.Scalar().Pick(suite.RandomStream())
@@ -349,3 +353,12 @@ func findPub(list []kyber.Point, i uint32) (kyber.Point, bool) {
 	}
 	return list[i], true
 }
+
+// precompute returns a copy of the given points that are faster to multiply.
+func precompute(points []kyber.Point) []kyber.Point {
+	pre := make([]kyber.Point, len(points))
+	for i, p := range points {
+		pre[i] = msm.Precompute(p)
+	}
+	return pre
+}
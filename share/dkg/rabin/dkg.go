@@ -46,6 +46,8 @@ import (
 
 	"github.com/dedis/kyber/share"
 	vss "github.com/dedis/kyber/share/vss/rabin"
+	"github.com/dedis/kyber/util/msm"
+	"github.com/dedis/kyber/util/strict"
 )
 
 // Suite wraps the functionalities needed by the dkg package
@@ -192,6 +194,9 @@ func NewDistKeyGenerator(suite Suite, longterm kyber.Scalar, participants []kybe
 	if !found {
 		return nil, errors.New("dkg: own public key not found in list of participants")
 	}
+	// The public keys are used to verify the signatures on the responses to
+	// every deal, so their tables are computed once for all verifiers.
+	participants = precompute(participants)
 	var err error
 	// generate our dealer / deal
 	ownSec := suite.Scalar().Pick(suite.RandomStream())
@@ -450,7 +455,11 @@ func (d *DistKeyGenerator) ProcessSecretCommits(sc *SecretCommits) (*ComplaintCo
 
 	deal := v.Deal()
 	poly := share.NewPubPoly(d.suite, d.suite.Point().Base(), sc.Commitments)
-	if !poly.Check(deal.SecShare) {
+	// With strict decoding, commitments outside of the prime-order subgroup
+	// or of small order are as wrong as commitments that do not verify the
+	// share.
+	invalid := strict.Enabled(d.suite) && strict.CheckPoints(sc.Commitments) != nil
+	if invalid || !poly.Check(deal.SecShare) {
 		cc := &ComplaintCommits{
 			Index:       uint32(d.index),
 			DealerIndex: sc.Index,
@@ -692,3 +701,12 @@ func findPub(list []kyber.Point, i uint32) (kyber.Point, bool) {
 	}
 	return list[i], true
 }
+
+// precompute returns a copy of the given points that are faster to multiply.
+func precompute(points []kyber.Point) []kyber.Point {
+	pre := make([]kyber.Point, len(points))
+	for i, p := range points {
+		pre[i] = msm.Precompute(p)
+	}
+	return pre
+}
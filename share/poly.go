@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/msm"
 )
 
 // Some error definitions
@@ -313,11 +314,13 @@ func (p *PubPoly) Commit() kyber.Point {
 // Eval computes the public share v = p(i).
 func (p *PubPoly) Eval(i int) *PubShare {
 	xi := p.g.Scalar().SetInt64(1 + int64(i)) // x-coordinate of this share
-	v := p.g.Point().Null()
-	for j := p.Threshold() - 1; j >= 0; j-- {
-		v.Mul(xi, v)
-		v.Add(v, p.commits[j])
+	powers := make([]kyber.Scalar, p.Threshold())
+	x := p.g.Scalar().One()
+	for j := range powers {
+		powers[j] = x.Clone()
+		x.Mul(x, xi)
 	}
+	v := msm.MultiMul(p.g.Point(), powers, p.commits)
 	return &PubShare{i, v}
 }
 
@@ -392,14 +395,13 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 		return nil, errors.New("share: not enough good public shares to reconstruct secret commitment")
 	}
 
-	num := g.Scalar()
 	den := g.Scalar()
 	tmp := g.Scalar()
-	Acc := g.Point().Null()
-	Tmp := g.Point()
+	coeffs := make([]kyber.Scalar, 0, len(x))
+	points := make([]kyber.Point, 0, len(x))
 
 	for i, xi := range x {
-		num.One()
+		num := g.Scalar().One()
 		den.One()
 		for j, xj := range x {
 			if i == j {
@@ -408,9 +410,9 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 			num.Mul(num, xj)
 			den.Mul(den, tmp.Sub(xj, xi))
 		}
-		Tmp.Mul(num.Div(num, den), shares[i].V)
-		Acc.Add(Acc, Tmp)
+		coeffs = append(coeffs, num.Div(num, den))
+		points = append(points, shares[i].V)
 	}
 
-	return Acc, nil
+	return msm.MultiMul(g.Point(), coeffs, points), nil
 }
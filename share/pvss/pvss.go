@@ -18,6 +18,8 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/proof/dleq"
 	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/msm"
+	"github.com/dedis/kyber/util/strict"
 )
 
 // Suite describes the functionalities needed by this package in order to
@@ -50,6 +52,10 @@ func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar,
 	n := len(X)
 	encShares := make([]*PubVerShare, n)
 
+	// H is multiplied by the coefficients of the polynomial and twice for
+	// each share.
+	H = msm.Precompute(H)
+
 	// Create secret sharing polynomial
 	priPoly := share.NewPriPoly(suite, t, secret, suite.RandomStream())
 
@@ -86,7 +92,11 @@ func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar,
 // VerifyEncShare checks that the encrypted share sX satisfies
 // log_{H}(sH) == log_{X}(sX) where sH is the public commitment computed by
 // evaluating the public commitment polynomial at the encrypted share's index i.
+// If the suite enables strict decoding, sX must also pass strict.Check.
 func VerifyEncShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, encShare *PubVerShare) error {
+	if strict.Enabled(suite) && strict.Check(encShare.S.V) != nil {
+		return errorEncVerification
+	}
 	if err := encShare.P.Verify(suite, H, X, sH, encShare.S.V); err != nil {
 		return errorEncVerification
 	}
@@ -150,7 +160,11 @@ func DecShareBatch(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber.Point
 
 // VerifyDecShare checks that the decrypted share sG satisfies
 // log_{G}(X) == log_{sG}(sX). Note that X = xG and sX = s(xG) = x(sG).
+// If the suite enables strict decoding, sG must also pass strict.Check.
 func VerifyDecShare(suite Suite, G kyber.Point, X kyber.Point, encShare *PubVerShare, decShare *PubVerShare) error {
+	if strict.Enabled(suite) && strict.Check(decShare.S.V) != nil {
+		return errorDecVerification
+	}
 	if err := decShare.P.Verify(suite, G, decShare.S.V, X, encShare.S.V); err != nil {
 		return errorDecVerification
 	}
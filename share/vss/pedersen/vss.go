@@ -15,6 +15,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/dedis/kyber/util/strict"
 	"github.com/dedis/protobuf"
 )
 
@@ -394,8 +395,8 @@ func (v *Verifier) decryptDeal(e *EncryptedDeal) (*Deal, error) {
 	}
 
 	// compute shared key and AES526-GCM cipher
-	dhKey := v.suite.Point()
-	if err := dhKey.UnmarshalBinary(e.DHKey); err != nil {
+	dhKey, err := strict.DecodePoint(v.suite, e.DHKey)
+	if err != nil {
 		return nil, err
 	}
 	pre := dhExchange(v.suite, v.longterm, dhKey)
@@ -550,6 +551,10 @@ func (a *aggregator) VerifyDeal(d *Deal, inclusion bool) error {
 		return errors.New("vss: find different sessionIDs from Deal")
 	}
 
+	if strict.Enabled(a.suite) && strict.CheckPoints(d.Commitments) != nil {
+		return errors.New("vss: invalid commitments in Deal")
+	}
+
 	fi := d.SecShare
 	if fi.I < 0 || fi.I >= len(a.verifiers) {
 		return errors.New("vss: index out of bounds in Deal")
@@ -677,15 +682,6 @@ func validT(t int, verifiers []kyber.Point) bool {
 	return t >= 2 && t <= len(verifiers) && int(uint32(t)) == t
 }
 
-func deriveH(suite Suite, verifiers []kyber.Point) kyber.Point {
-	var b bytes.Buffer
-	for _, v := range verifiers {
-		_, _ = v.MarshalTo(&b)
-	}
-	base := suite.Point().Pick(suite.XOF(b.Bytes()))
-	return base
-}
-
 func findPub(verifiers []kyber.Point, idx uint32) (kyber.Point, bool) {
 	iidx := int(idx)
 	if iidx >= len(verifiers) {
@@ -7,6 +7,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
 	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/dedis/kyber/util/strict"
 	"github.com/dedis/kyber/xof/blake2xb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -212,8 +213,22 @@ func TestVSSVerifierDecryptDeal(t *testing.T) {
 	assert.Nil(t, decD)
 	encD.DHKey = goodDh
 
-	// wrong signature
+	// dh key of small order, even though signed by the dealer, with strict
+	// decoding
 	goodSig := encD.Signature
+	encD.DHKey, err = suite.Point().Null().MarshalBinary()
+	require.Nil(t, err)
+	encD.Signature, err = schnorr.Sign(suite, dealerSec, encD.DHKey)
+	require.Nil(t, err)
+	v.suite = &strictSuite{suite}
+	decD, err = v.decryptDeal(encD)
+	assert.Equal(t, strict.ErrSmallOrder, err)
+	assert.Nil(t, decD)
+	v.suite = suite
+	encD.DHKey = goodDh
+	encD.Signature = goodSig
+
+	// wrong signature
 	encD.Signature = randomBytes(32)
 	decD, err = v.decryptDeal(encD)
 	assert.Error(t, err)
@@ -593,6 +608,15 @@ func TestVSSContext(t *testing.T) {
 	assert.Len(t, c, suite.Hash().Size())
 }
 
+// strictSuite enables strict decoding.
+type strictSuite struct {
+	*edwards25519.SuiteEd25519
+}
+
+func (s *strictSuite) StrictDecoding() bool {
+	return true
+}
+
 func genPair() (kyber.Scalar, kyber.Point) {
 	secret := suite.Scalar().Pick(suite.RandomStream())
 	public := suite.Point().Mul(secret, nil)
@@ -39,6 +39,8 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/dedis/kyber/util/msm"
+	"github.com/dedis/kyber/util/strict"
 	"github.com/dedis/protobuf"
 )
 
@@ -145,7 +147,8 @@ func NewDealer(suite Suite, longterm, secret kyber.Scalar, verifiers []kyber.Poi
 	}
 	d.t = t
 
-	H := deriveH(d.suite, d.verifiers)
+	// H is multiplied by all the coefficients of g.
+	H := msm.Precompute(deriveH(d.suite, d.verifiers))
 	f := share.NewPriPoly(d.suite, d.t, d.secret, suite.RandomStream())
 	g := share.NewPriPoly(d.suite, d.t, nil, suite.RandomStream())
 	d.pub = d.suite.Point().Mul(d.long, nil)
@@ -166,7 +169,7 @@ func NewDealer(suite Suite, longterm, secret kyber.Scalar, verifiers []kyber.Poi
 		return nil, err
 	}
 
-	d.aggregator = newAggregator(d.suite, d.pub, d.verifiers, commitments, d.t, d.sessionID)
+	d.aggregator = newAggregator(d.suite, d.pub, d.verifiers, H, commitments, d.t, d.sessionID)
 	// C = F + G
 	d.deals = make([]*Deal, len(d.verifiers))
 	for i := range d.verifiers {
@@ -388,7 +391,9 @@ func (v *Verifier) ProcessEncryptedDeal(e *EncryptedDeal) (*Response, error) {
 	}
 
 	if v.aggregator == nil {
-		v.aggregator = newAggregator(v.suite, v.dealer, v.verifiers, d.Commitments, t, d.SessionID)
+		// H is multiplied by the share of every deal the verifier checks.
+		H := msm.Precompute(deriveH(v.suite, v.verifiers))
+		v.aggregator = newAggregator(v.suite, v.dealer, v.verifiers, H, d.Commitments, t, d.SessionID)
 	}
 
 	r := &Response{
@@ -424,6 +429,12 @@ func (v *Verifier) decryptDeal(e *EncryptedDeal) (*Deal, error) {
 		return nil, err
 	}
 
+	if strict.Enabled(v.suite) {
+		if err := strict.Check(e.DHKey); err != nil {
+			return nil, err
+		}
+	}
+
 	// compute shared key and AES526-GCM cipher
 	pre := dhExchange(v.suite, v.longterm, e.DHKey)
 	gcm, err := newAEAD(v.suite.Hash, pre, v.hkdfContext)
@@ -511,6 +522,7 @@ type aggregator struct {
 	suite     Suite
 	dealer    kyber.Point
 	verifiers []kyber.Point
+	h         kyber.Point
 	commits   []kyber.Point
 
 	responses map[uint32]*Response
@@ -520,11 +532,12 @@ type aggregator struct {
 	badDealer bool
 }
 
-func newAggregator(suite Suite, dealer kyber.Point, verifiers, commitments []kyber.Point, t int, sid []byte) *aggregator {
+func newAggregator(suite Suite, dealer kyber.Point, verifiers []kyber.Point, h kyber.Point, commitments []kyber.Point, t int, sid []byte) *aggregator {
 	agg := &aggregator{
 		suite:     suite,
 		dealer:    dealer,
 		verifiers: verifiers,
+		h:         h,
 		commits:   commitments,
 		t:         t,
 		sid:       sid,
@@ -557,6 +570,10 @@ func (a *aggregator) VerifyDeal(d *Deal, inclusion bool) error {
 		return errors.New("vss: find different sessionIDs from Deal")
 	}
 
+	if strict.Enabled(a.suite) && strict.CheckPoints(d.Commitments) != nil {
+		return errors.New("vss: invalid commitments in Deal")
+	}
+
 	fi := d.SecShare
 	gi := d.RndShare
 	if fi.I != gi.I {
@@ -567,8 +584,7 @@ func (a *aggregator) VerifyDeal(d *Deal, inclusion bool) error {
 	}
 	// compute fi * G + gi * H
 	fig := a.suite.Point().Base().Mul(fi.V, nil)
-	H := deriveH(a.suite, a.verifiers)
-	gih := a.suite.Point().Mul(gi.V, H)
+	gih := a.suite.Point().Mul(gi.V, a.h)
 	ci := a.suite.Point().Add(fig, gih)
 
 	commitPoly := share.NewPubPoly(a.suite, nil, d.Commitments)
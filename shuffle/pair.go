@@ -26,6 +26,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/proof"
+	"github.com/dedis/kyber/util/msm"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -281,15 +282,18 @@ func (ps *PairShuffle) Verify(
 	}
 
 	// V step 7
-	Phi1 := grp.Point().Null()
-	Phi2 := grp.Point().Null()
+	scalars := make([]kyber.Scalar, 2*k)
+	for i := 0; i < k; i++ {
+		scalars[i] = p5.Zsigma[i]
+		scalars[k+i] = grp.Scalar().Neg(v2.Zrho[i])
+	}
+	Phi1 := msm.MultiMul(grp.Point(), scalars,
+		append(append([]kyber.Point{}, Xbar...), X...)) // (31)
+	Phi2 := msm.MultiMul(grp.Point(), scalars,
+		append(append([]kyber.Point{}, Ybar...), Y...)) // (32)
 	P := grp.Point() // scratch
 	Q := grp.Point() // scratch
 	for i := 0; i < k; i++ {
-		Phi1 = Phi1.Add(Phi1, P.Mul(p5.Zsigma[i], Xbar[i])) // (31)
-		Phi1 = Phi1.Sub(Phi1, P.Mul(v2.Zrho[i], X[i]))
-		Phi2 = Phi2.Add(Phi2, P.Mul(p5.Zsigma[i], Ybar[i])) // (32)
-		Phi2 = Phi2.Sub(Phi2, P.Mul(v2.Zrho[i], Y[i]))
 		//		println("i",i)
 		if !P.Mul(p5.Zsigma[i], p1.Gamma).Equal( // (33)
 			Q.Add(p1.W[i], p3.D[i])) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
@@ -94,3 +95,26 @@ func ExampleEncrypt_anonSet() {
 	// 00000090  1e 37 4d ab 06 63 d2 37  97 d5 45 2a              |.7M..c.7..E*|
 	// Decrypted: 'Hello World!'
 }
+
+func TestEncryptHide(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	X := make([]kyber.Point, 3)
+	for i := range X {
+		X[i] = suite.Point().Pick(suite.RandomStream())
+	}
+	mine := 2
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X[mine] = suite.Point().Mul(x, nil)
+
+	M := []byte("Hello World!")
+	for i := 0; i < 10; i++ {
+		C := Encrypt(suite, M, Set(X), true)
+		MM, err := Decrypt(suite, C, Set(X), mine, x, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(M, MM) {
+			t.Fatal("Decryption failed to reproduce message")
+		}
+	}
+}
@@ -22,7 +22,10 @@ func NewKeyPair(suite pairing.Suite, random cipher.Stream) (kyber.Scalar, kyber.
 // Sign creates a BLS signature S = x * H(m) on a message m using the private
 // key x. The signature S is a point on curve G1.
 func Sign(suite pairing.Suite, x kyber.Scalar, msg []byte) ([]byte, error) {
-	HM := hashToPoint(suite, msg)
+	HM, err := hashToPoint(suite, msg)
+	if err != nil {
+		return nil, err
+	}
 	xHM := HM.Mul(x, HM)
 	s, err := xHM.MarshalBinary()
 	if err != nil {
@@ -34,26 +37,53 @@ func Sign(suite pairing.Suite, x kyber.Scalar, msg []byte) ([]byte, error) {
 // Verify checks the given BLS signature S on the message m using the public
 // key X by verifying that the equality e(H(m), X) == e(H(m), x*B2) ==
 // e(x*H(m), B2) == e(S, B2) holds where e is the pairing operation and B2 is
-// the base point from curve G2.
+// the base point from curve G2. Both pairings are computed together, as
+// e(H(m), X) * e(-S, B2) == 1.
 func Verify(suite pairing.Suite, X kyber.Point, msg, sig []byte) error {
-	HM := hashToPoint(suite, msg)
-	left := suite.Pair(HM, X)
+	HM, err := hashToPoint(suite, msg)
+	if err != nil {
+		return err
+	}
 	s := suite.G1().Point()
 	if err := s.UnmarshalBinary(sig); err != nil {
 		return err
 	}
-	right := suite.Pair(s, suite.G2().Point().Base())
-	if !left.Equal(right) {
+	s.Neg(s)
+	if !suite.PairingCheck([]kyber.Point{HM, s}, []kyber.Point{X, suite.G2().Point().Base()}) {
 		return errors.New("bls: invalid signature")
 	}
 	return nil
 }
 
-// hashToPoint hashes a message to a point on curve G1. XXX: This should be replaced
-// eventually by a proper hash-to-point mapping like Elligator.
-func hashToPoint(suite pairing.Suite, msg []byte) kyber.Point {
-	h := suite.Hash()
-	h.Write(msg)
-	x := suite.G1().Scalar().SetBytes(h.Sum(nil))
-	return suite.G1().Point().Mul(x, nil)
+// legacyDomainSeparationTag is the tag with which messages are hashed to G1
+// when its points do not name the RFC 9380 suite of their Hash.
+var legacyDomainSeparationTag = []byte("BLS_SIG_KYBER_XMD:SHA-256_RO_NUL_")
+
+// DomainSeparationTag returns the tag with which messages are hashed to G1,
+// which is the standard ciphersuite identifier of the basic scheme of the
+// IETF BLS signature draft, "BLS_SIG_" followed by the RFC 9380 suite of G1
+// and "NUL_", such as "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_" for
+// BLS12-381. Signatures are thus interoperable with other implementations
+// of this ciphersuite. Points of G1 that implement kyber.HashablePoint but
+// not kyber.HashSuitePoint keep the former tag of this package,
+// "BLS_SIG_KYBER_XMD:SHA-256_RO_NUL_".
+func DomainSeparationTag(suite pairing.Suite) ([]byte, error) {
+	switch p := suite.G1().Point().(type) {
+	case kyber.HashSuitePoint:
+		return []byte("BLS_SIG_" + p.HashSuite() + "NUL_"), nil
+	case kyber.HashablePoint:
+		return legacyDomainSeparationTag, nil
+	}
+	return nil, errors.New("bls: G1 points do not support hashing")
+}
+
+// hashToPoint hashes a message to a point on curve G1, whose discrete
+// logarithm must remain unknown for signatures to be unforgeable. The points
+// of G1 must therefore implement kyber.HashablePoint.
+func hashToPoint(suite pairing.Suite, msg []byte) (kyber.Point, error) {
+	dst, err := DomainSeparationTag(suite)
+	if err != nil {
+		return nil, err
+	}
+	return suite.G1().Point().(kyber.HashablePoint).Hash(msg, dst), nil
 }
@@ -3,6 +3,8 @@ package bls
 import (
 	"testing"
 
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing/bls12381"
 	"github.com/dedis/kyber/pairing/bn256"
 	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/require"
@@ -18,6 +20,47 @@ func TestBLS(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestBLS12381(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	suite := bls12381.NewSuite()
+	private, public := NewKeyPair(suite, random.New())
+	sig, err := Sign(suite, private, msg)
+	require.Nil(t, err)
+	require.Equal(t, 48, len(sig))
+	err = Verify(suite, public, msg, sig)
+	require.Nil(t, err)
+}
+
+func TestDomainSeparationTag(t *testing.T) {
+	dst, err := DomainSeparationTag(bn256.NewSuite())
+	require.NoError(t, err)
+	require.Equal(t, "BLS_SIG_BN256G1_XMD:SHA-256_SVDW_RO_NUL_", string(dst))
+
+	dst, err = DomainSeparationTag(bls12381.NewSuite())
+	require.NoError(t, err)
+	require.Equal(t, "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_", string(dst))
+
+	dst, err = DomainSeparationTag(legacySuite{bn256.NewSuite()})
+	require.NoError(t, err)
+	require.Equal(t, "BLS_SIG_KYBER_XMD:SHA-256_RO_NUL_", string(dst))
+}
+
+// legacySuite has G1 points that implement kyber.HashablePoint but not
+// kyber.HashSuitePoint.
+type legacySuite struct{ *bn256.Suite }
+
+func (s legacySuite) G1() kyber.Group { return legacyGroup{s.Suite.G1()} }
+
+type legacyGroup struct{ kyber.Group }
+
+func (g legacyGroup) Point() kyber.Point { return legacyPoint{g.Group.Point()} }
+
+type legacyPoint struct{ kyber.Point }
+
+func (p legacyPoint) Hash(msg, dst []byte) kyber.Point {
+	return p.Point.(kyber.HashablePoint).Hash(msg, dst)
+}
+
 func TestBLSFailSig(t *testing.T) {
 	msg := []byte("Hello Boneh-Lynn-Shacham")
 	suite := bn256.NewSuite()
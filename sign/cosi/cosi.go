@@ -44,6 +44,9 @@ import (
 	"fmt"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/msm"
+	"github.com/dedis/kyber/util/scalar"
+	"github.com/dedis/kyber/util/strict"
 )
 
 // Commit returns a random scalar v, generated from the given suite,
@@ -76,7 +79,10 @@ func AggregateCommitments(suite Suite, commitments []kyber.Point, masks [][]byte
 
 // Challenge creates the collective challenge from the given aggregate
 // commitment V, aggregate public key A, and message M, i.e., it returns
-// c = H(V || A || M).
+// c = H(V || A || M). If the suite enables wide challenges, as described in
+// package github.com/dedis/kyber/util/scalar, the digest is expanded before
+// its reduction to a scalar so that c is not biased, and signatures do not
+// verify with the suites that do not enable them.
 func Challenge(suite Suite, commitment, public kyber.Point, message []byte) (kyber.Scalar, error) {
 	if commitment == nil {
 		return nil, errors.New("no commitment provided")
@@ -92,7 +98,7 @@ func Challenge(suite Suite, commitment, public kyber.Point, message []byte) (kyb
 		return nil, err
 	}
 	hash.Write(message)
-	return suite.Scalar().SetBytes(hash.Sum(nil)), nil
+	return scalar.Challenge(suite, hash.Sum(nil)), nil
 }
 
 // Response creates the response from the given random scalar v, (collective)
@@ -154,7 +160,10 @@ func Sign(suite Suite, commitment kyber.Point, response kyber.Scalar, mask *Mask
 }
 
 // Verify checks the given cosignature on the provided message using the list
-// of public keys and cosigning policy.
+// of public keys and cosigning policy. If the suite enables strict decoding,
+// as described in package github.com/dedis/kyber/util/strict, the aggregate
+// commitment of the signature must also be canonically encoded, in the
+// prime-order subgroup and not of small order.
 func Verify(suite Suite, publics []kyber.Point, message, sig []byte, policy Policy) error {
 	if publics == nil {
 		return errors.New("no public keys provided")
@@ -171,8 +180,8 @@ func Verify(suite Suite, publics []kyber.Point, message, sig []byte, policy Poli
 
 	lenCom := suite.PointLen()
 	VBuff := sig[:lenCom]
-	V := suite.Point()
-	if err := V.UnmarshalBinary(VBuff); err != nil {
+	V, err := strict.DecodePoint(suite, VBuff)
+	if err != nil {
 		return errors.New("unmarshalling of commitment failed")
 	}
 
@@ -205,9 +214,8 @@ func Verify(suite Suite, publics []kyber.Point, message, sig []byte, policy Poli
 	// from s = k * a + r => s * B = k * a * B + r * B <=> s*B = k*A + r*B
 	// <=> s*B + k*-A = r*B
 	minusPublic := suite.Point().Neg(A)
-	kA := suite.Point().Mul(k, minusPublic)
-	sB := suite.Point().Mul(r, nil)
-	left := suite.Point().Add(kA, sB)
+	left := msm.MultiMul(suite.Point(), []kyber.Scalar{k, r},
+		[]kyber.Point{minusPublic, nil})
 
 	if !left.Equal(V) || !policy.Check(mask) {
 		return errors.New("invalid signature")
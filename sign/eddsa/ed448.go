@@ -0,0 +1,163 @@
+package eddsa
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/ed448"
+	"github.com/dedis/kyber/util/random"
+	"golang.org/x/crypto/sha3"
+)
+
+var group448 = new(ed448.Curve)
+
+// Sizes of the Ed448 seeds and signatures of RFC 8032. Ed448 public keys
+// are ed448.Ed448PointLen bytes long.
+const (
+	Ed448SeedSize      = 57
+	Ed448SignatureSize = 114
+)
+
+// dom4 is the prefix of the hashes of Ed448, for pure signatures without
+// context.
+var dom4 = []byte("SigEd448\x00\x00")
+
+// EdDSA448 is a structure holding the data necessary to make a series of
+// Ed448 signatures. Its public key is an element of the Decaf448 group of
+// package github.com/dedis/kyber/group/ed448, so that it can for instance
+// be the result of a distributed key generation.
+type EdDSA448 struct {
+	// Secret being already hashed + bit tweaked
+	Secret kyber.Scalar
+	// Public is the corresponding public key
+	Public kyber.Point
+
+	seed   []byte
+	prefix []byte
+}
+
+// NewEdDSA448 will return a freshly generated key pair to use for generating
+// Ed448 signatures.
+func NewEdDSA448(stream cipher.Stream) *EdDSA448 {
+	if stream == nil {
+		panic("stream is required")
+	}
+	seed := make([]byte, Ed448SeedSize)
+	random.Bytes(seed, stream)
+
+	e := &EdDSA448{}
+	e.setSeed(seed)
+	return e
+}
+
+func (e *EdDSA448) setSeed(seed []byte) {
+	scalar := hashSeed448(seed)
+	e.seed = seed
+	e.prefix = scalar[57:]
+	e.Secret = group448.Scalar().SetBytes(scalar[:57])
+	e.Public = group448.Point().Mul(e.Secret, ed448.Ed448Base())
+}
+
+// MarshalBinary will return the representation of RFC 8032, which is
+// "seed || Public".
+func (e *EdDSA448) MarshalBinary() ([]byte, error) {
+	eddsa := make([]byte, Ed448SeedSize+ed448.Ed448PointLen)
+	copy(eddsa, e.seed)
+	copy(eddsa[Ed448SeedSize:], ed448.MarshalEd448(e.Public))
+	return eddsa, nil
+}
+
+// UnmarshalBinary transforms a slice of bytes into a EdDSA448 key pair.
+func (e *EdDSA448) UnmarshalBinary(buff []byte) error {
+	if len(buff) != Ed448SeedSize+ed448.Ed448PointLen {
+		return errors.New("wrong length for decoding EdDSA448 private")
+	}
+	e.setSeed(buff[:Ed448SeedSize])
+	return nil
+}
+
+// Sign will return a EdDSA signature of the message msg using Ed448.
+func (e *EdDSA448) Sign(msg []byte) ([]byte, error) {
+	// deterministic random secret and its commit
+	r := group448.Scalar().SetBytes(hash448(e.prefix, msg))
+	R := group448.Point().Mul(r, ed448.Ed448Base())
+
+	// challenge
+	// H( R || Public || Msg)
+	Rbuff := ed448.MarshalEd448(R)
+	h := group448.Scalar().SetBytes(hash448(Rbuff, ed448.MarshalEd448(e.Public), msg))
+
+	// response
+	// s = r + h * s
+	s := group448.Scalar().Mul(e.Secret, h)
+	s.Add(r, s)
+
+	sBuff, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	// return R || s, where s takes 57 bytes
+	var sig [Ed448SignatureSize]byte
+	copy(sig[:], Rbuff)
+	copy(sig[ed448.Ed448PointLen:], sBuff)
+
+	return sig[:], nil
+}
+
+// Verify448 uses a public key, a message and a signature. It will return nil
+// if sig is a valid Ed448 signature for msg created by key public, or an
+// error otherwise. It implements the cofactored verification of RFC 8032.
+func Verify448(public kyber.Point, msg, sig []byte) error {
+	if len(sig) != Ed448SignatureSize {
+		return fmt.Errorf("signature length invalid, expect %d but got %v", Ed448SignatureSize, len(sig))
+	}
+
+	R, err := ed448.UnmarshalEd448(sig[:ed448.Ed448PointLen])
+	if err != nil {
+		return fmt.Errorf("got R invalid point: %s", err)
+	}
+
+	if sig[Ed448SignatureSize-1] != 0 {
+		return errors.New("schnorr: s invalid scalar")
+	}
+	s := group448.Scalar()
+	if err := s.UnmarshalBinary(sig[ed448.Ed448PointLen : Ed448SignatureSize-1]); err != nil {
+		return fmt.Errorf("schnorr: s invalid scalar %s", err)
+	}
+
+	// reconstruct h = H(R || Public || Msg)
+	h := group448.Scalar().SetBytes(hash448(sig[:ed448.Ed448PointLen], ed448.MarshalEd448(public), msg))
+
+	// reconstruct S == k*A + R
+	S := group448.Point().Mul(s, ed448.Ed448Base())
+	hA := group448.Point().Mul(h, public)
+	RhA := group448.Point().Add(R, hA)
+
+	if !RhA.Equal(S) {
+		return errors.New("reconstructed S is not equal to signature")
+	}
+	return nil
+}
+
+// hash448 returns the 114-byte SHAKE256 hash of dom4 and the given data.
+func hash448(data ...[]byte) []byte {
+	hash := sha3.NewShake256()
+	_, _ = hash.Write(dom4)
+	for _, d := range data {
+		_, _ = hash.Write(d)
+	}
+	out := make([]byte, 114)
+	_, _ = hash.Read(out)
+	return out
+}
+
+func hashSeed448(seed []byte) (hash [114]byte) {
+	sha3.ShakeSum256(hash[:], seed)
+	hash[0] &= 0xfc
+	hash[56] = 0
+	hash[55] |= 0x80
+	return
+}
@@ -0,0 +1,67 @@
+package eddsa
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/group/ed448"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Ed448TestVectors taken from RFC8032 section 7.4
+var Ed448TestVectors = []struct {
+	private   string
+	public    string
+	message   string
+	signature string
+}{
+	{"6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b",
+		"5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180",
+		"",
+		"533a37f6bbe457251f023c0d88f976ae2dfb504a843e34d2074fd823d41a591f2b233f034f628281f2fd7a22ddd47d7828c59bd0a21bfd3980ff0d2028d4b18a9df63e006c5d1c2d345b925d8dc00b4104852db99ac5c7cdda8530a113a0f4dbb61149f05a7363268c71d95808ff2e652600"},
+	{"c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		"43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		"03",
+		"26b8f91727bd62897af15e41eb43c377efb9c610d48f2335cb0bd0087810f4352541b143c4b981b7e18f62de8ccdf633fc1bf037ab7cd779805e0dbcc0aae1cbcee1afb2e027df36bc04dcecbf154336c19f0af7e0a6472905e799f1953d2a0ff3348ab21aa4adafd1d234441cf807c03a00"},
+}
+
+func TestEd448Vectors(t *testing.T) {
+	for i, vec := range Ed448TestVectors {
+		seed, _ := hex.DecodeString(vec.private)
+		msg, _ := hex.DecodeString(vec.message)
+
+		e := NewEdDSA448(ConstantStream(seed))
+		assert.Equal(t, vec.public, hex.EncodeToString(ed448.MarshalEd448(e.Public)), "test vector %d", i)
+
+		sig, err := e.Sign(msg)
+		require.NoError(t, err)
+		assert.Equal(t, vec.signature, hex.EncodeToString(sig), "test vector %d", i)
+
+		pub, _ := hex.DecodeString(vec.public)
+		public, err := ed448.UnmarshalEd448(pub)
+		require.NoError(t, err)
+		require.NoError(t, Verify448(public, msg, sig))
+	}
+}
+
+func TestEd448SignVerify(t *testing.T) {
+	e := NewEdDSA448(random.New())
+	msg := []byte("Hello Ed448")
+	sig, err := e.Sign(msg)
+	require.NoError(t, err)
+	require.NoError(t, Verify448(e.Public, msg, sig))
+
+	require.Error(t, Verify448(e.Public, []byte("Hello Ed25519"), sig))
+	require.Error(t, Verify448(e.Public, msg, sig[:len(sig)-1]))
+	sig[Ed448SignatureSize-1] = 1
+	require.Error(t, Verify448(e.Public, msg, sig))
+
+	buf, err := e.MarshalBinary()
+	require.NoError(t, err)
+	e2 := &EdDSA448{}
+	require.NoError(t, e2.UnmarshalBinary(buf))
+	require.True(t, e.Public.Equal(e2.Public))
+	require.True(t, e.Secret.Equal(e2.Secret))
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/scalar"
 )
 
 // Suite represents the set of functionalities needed by the package schnorr.
@@ -93,6 +94,8 @@ func Verify(g kyber.Group, public kyber.Point, msg, sig []byte) error {
 	return nil
 }
 
+// hash returns the challenge H(R || public || msg), where H is SHA-512,
+// derived with scalar.Challenge.
 func hash(g kyber.Group, public, r kyber.Point, msg []byte) (kyber.Scalar, error) {
 	h := sha512.New()
 	if _, err := r.MarshalTo(h); err != nil {
@@ -104,5 +107,5 @@ func hash(g kyber.Group, public, r kyber.Point, msg []byte) (kyber.Scalar, error
 	if _, err := h.Write(msg); err != nil {
 		return nil, err
 	}
-	return g.Scalar().SetBytes(h.Sum(nil)), nil
+	return scalar.Challenge(g, h.Sum(nil)), nil
 }
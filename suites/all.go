@@ -1,9 +1,34 @@
 package suites
 
 import (
+	"github.com/dedis/kyber/group/curve25519"
+	"github.com/dedis/kyber/group/ed448"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/group/ristretto255"
+	"github.com/dedis/kyber/group/secp256k1"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/pairing/bn256"
 )
 
 func init() {
-	register(edwards25519.NewBlakeSHA256Ed25519())
+	Register(ed448.NewShakeSHA512Ed448(), 224, true)
+	Register(edwards25519.NewBlakeSHA256Ed25519(), 128, true)
+	Register(nist.NewBlakeSHA256P256(), 128, true)
+	Register(ristretto255.NewBlakeSHA256Ristretto255(), 128, true)
+	Register(secp256k1.NewBlakeSHA256Secp256k1(), 128, true)
+
+	// The generic Edwards curves are registered by default since they use
+	// ConstantTimeCurve, which is built without the vartime tag and does not
+	// depend on the variable-time implementations of their package. Their
+	// security levels are the ones estimated by SafeCurves.
+	Register(curve25519.NewBlakeSHA256ConstantTime(curve25519.ConstantTimeParam1174()), 124, true)
+	Register(curve25519.NewBlakeSHA256ConstantTime(curve25519.ConstantTimeParamE382()), 188, true)
+	Register(curve25519.NewBlakeSHA256ConstantTime(curve25519.ConstantTimeParam41417()), 205, true)
+	Register(curve25519.NewBlakeSHA256ConstantTime(curve25519.ConstantTimeParamE521()), 259, true)
+
+	// Security levels of the pairings after the improved attacks on
+	// discrete logarithms in GT of Kim and Barbulescu.
+	RegisterPairing("bn256", bn256.NewSuite(), 100, false)
+	RegisterPairing("bls12381", bls12381.NewSuite(), 117, false)
 }
@@ -7,9 +7,20 @@ import (
 	"github.com/dedis/kyber/group/nist"
 )
 
+// ffdhLevels are the security levels of the RFC 3526 and RFC 7919 groups of
+// each size, as estimated in RFC 7919.
+var ffdhLevels = map[int]int{2048: 103, 3072: 125, 4096: 150, 6144: 175, 8192: 192}
+
 func init() {
-	register(curve25519.NewBlakeSHA256Curve25519(false))
-	register(curve25519.NewBlakeSHA256Curve25519(true))
-	register(nist.NewBlakeSHA256P256())
-	register(nist.NewBlakeSHA256QR512())
+	Register(curve25519.NewBlakeSHA256Curve25519(false), 128, false)
+	Register(curve25519.NewBlakeSHA256Curve25519(true), 128, false)
+	Register(nist.NewShakeSHA384P384(), 192, false)
+	Register(nist.NewShakeSHA512P521(), 256, false)
+	Register(nist.NewBlakeSHA256QR512(), 56, false)
+	for bits, level := range ffdhLevels {
+		modp, _ := nist.NewBlakeSHA256MODP(bits)
+		Register(modp, level, false)
+		ffdhe, _ := nist.NewBlakeSHA256FFDHE(bits)
+		Register(ffdhe, level, false)
+	}
 }
@@ -0,0 +1,191 @@
+package suites
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake2xb"
+)
+
+// Builder composes a Suite from any kyber.Group and a choice of hash
+// function, XOF, source of randomness and encoding, such as SHA-512 and
+// SHAKE256 for deployments that require FIPS-approved primitives:
+//
+//	suite := suites.NewBuilder(new(edwards25519.Curve)).
+//		WithName("Ed25519-SHA512").
+//		WithHash(sha512.New).
+//		WithXOF(keccak.New).
+//		Build()
+//
+// The defaults are the ones of the suites of this library: SHA-256,
+// package github.com/dedis/kyber/xof/blake2xb, crypto/rand, and the fixbuf
+// encoding.
+type Builder struct {
+	s builtSuite
+}
+
+// NewBuilder returns a Builder of suites based on the group g, named after
+// it and using the default primitives.
+func NewBuilder(g kyber.Group) *Builder {
+	b := &Builder{builtSuite{
+		Group: g,
+		name:  g.String(),
+		hash:  sha256.New,
+		xof:   blake2xb.New,
+	}}
+	return b
+}
+
+// WithName sets the name returned by the String method of the suite, under
+// which Register registers it.
+func (b *Builder) WithName(name string) *Builder {
+	b.s.name = name
+	return b
+}
+
+// WithHash sets the function that creates the hashes of the suite, such as
+// sha512.New.
+func (b *Builder) WithHash(h func() hash.Hash) *Builder {
+	b.s.hash = h
+	return b
+}
+
+// WithXOF sets the function that creates the XOFs of the suite, such as
+// keccak.New.
+func (b *Builder) WithXOF(x func(seed []byte) kyber.XOF) *Builder {
+	b.s.xof = x
+	return b
+}
+
+// WithRandom sets the stream that RandomStream returns, instead of a
+// stream reading from crypto/rand. The stream must tolerate being used in
+// multiple goroutines.
+func (b *Builder) WithRandom(r cipher.Stream) *Builder {
+	b.s.r = r
+	return b
+}
+
+// WithEncoding sets the encoding of the suite, instead of the fixbuf
+// encoding.
+func (b *Builder) WithEncoding(e kyber.Encoding) *Builder {
+	b.s.enc = e
+	return b
+}
+
+// WithStrictDecoding makes the suite enable strict decoding, so that the
+// protocols of this library check the points they receive from other
+// parties with package github.com/dedis/kyber/util/strict.
+func (b *Builder) WithStrictDecoding() *Builder {
+	b.s.strict = true
+	return b
+}
+
+// WithWideChallenges makes the suite enable wide challenges, so that the
+// schnorr and cosi signatures derive their challenges without bias, as
+// package github.com/dedis/kyber/util/scalar describes. Their signatures
+// are not compatible with the ones of suites without wide challenges.
+func (b *Builder) WithWideChallenges() *Builder {
+	b.s.wide = true
+	return b
+}
+
+// Build returns a new suite with the current settings of the builder,
+// which can be reused to build further suites.
+func (b *Builder) Build() Suite {
+	s := b.s
+	return &s
+}
+
+// builtSuite is the Suite that a Builder returns. It implements the
+// key.Generator interface, so that keys are still generated as its group
+// requires, but no other optional interface of the group, such as
+// kyber.GroupInfo. The optional interfaces of the points and scalars are
+// unaffected.
+type builtSuite struct {
+	kyber.Group
+	name string
+	hash func() hash.Hash
+	xof  func([]byte) kyber.XOF
+	r    cipher.Stream
+	enc  kyber.Encoding
+
+	strict bool
+	wide   bool
+}
+
+func (s *builtSuite) String() string {
+	return s.name
+}
+
+// StrictDecoding implements the strict.Suite interface.
+func (s *builtSuite) StrictDecoding() bool {
+	return s.strict
+}
+
+// WideChallenges implements the scalar.Suite interface.
+func (s *builtSuite) WideChallenges() bool {
+	return s.wide
+}
+
+func (s *builtSuite) Hash() hash.Hash {
+	return s.hash()
+}
+
+func (s *builtSuite) XOF(seed []byte) kyber.XOF {
+	return s.xof(seed)
+}
+
+func (s *builtSuite) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+func (s *builtSuite) Read(r io.Reader, objs ...interface{}) error {
+	if s.enc != nil {
+		return s.enc.Read(r, objs...)
+	}
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *builtSuite) Write(w io.Writer, objs ...interface{}) error {
+	if s.enc != nil {
+		return s.enc.Write(w, objs...)
+	}
+	return fixbuf.Write(w, objs...)
+}
+
+var (
+	tScalar = reflect.TypeOf((*kyber.Scalar)(nil)).Elem()
+	tPoint  = reflect.TypeOf((*kyber.Point)(nil)).Elem()
+)
+
+// New implements the fixbuf.Constructor interface, by creating the scalars
+// and points that the fixbuf encoding reads.
+func (s *builtSuite) New(t reflect.Type) interface{} {
+	switch t {
+	case tScalar:
+		return s.Scalar()
+	case tPoint:
+		return s.Point()
+	}
+	return nil
+}
+
+// NewKey returns a secret key generated as the group does, if it
+// implements the key.Generator interface, or a random scalar otherwise.
+// NewKey implements the key.Generator interface.
+func (s *builtSuite) NewKey(stream cipher.Stream) kyber.Scalar {
+	if g, ok := s.Group.(key.Generator); ok {
+		return g.NewKey(stream)
+	}
+	return s.Scalar().Pick(stream)
+}
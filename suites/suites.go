@@ -1,19 +1,31 @@
 // Package suites allows callers to look up Kyber suites by name.
 //
-// Currently, only the "ed25519" suite is available by default. To
-// have access to "curve25519" and the NIST suites (i.e. "P256"),
-// one needs to call the "go" tool with the tag "vartime", such as:
+// Currently, only the "ed25519", "ristretto255", "decaf448", "secp256k1",
+// "P256", "curve1174", "e-382", "curve41417" and "e-521" suites, and the
+// "bn256" and "bls12381" pairing suites, are available by default. To have
+// access to "curve25519", the
+// other NIST suites (i.e. "P384" and "P521"), "residue512", and the
+// finite-field Diffie-Hellman groups of RFC 3526 and RFC 7919 (i.e.
+// "modp2048" to "modp8192" and "ffdhe2048" to "ffdhe8192"), one needs to
+// call the "go" tool with the tag "vartime", such as:
 //
 //   go build -tags vartime
 //   go install -tags vartime
 //   go test -tags vartime
+//
+// Other packages can make their own suites known with Register and
+// RegisterPairing. Suites and pairing suites share a single namespace, so
+// that Lookup and List describe all of them, whichever registry they are in.
 package suites
 
 import (
 	"errors"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
 )
 
 // Suite is the sum of all suites mix-ins in Kyber.
@@ -25,12 +37,68 @@ type Suite interface {
 	kyber.Random
 }
 
-var suites = map[string]Suite{}
+// Info describes a registered suite.
+type Info struct {
+	// Name is the lower-case name under which the suite is registered.
+	Name string
+	// SecurityLevel is the estimated security of the suite in bits.
+	SecurityLevel int
+	// PointLen and ScalarLen are the lengths in bytes of the encodings
+	// of the points and scalars of the group, or of G1 for pairing
+	// suites.
+	PointLen  int
+	ScalarLen int
+	// ConstantTime tells whether the operations on secret values run in
+	// constant time.
+	ConstantTime bool
+	// Pairing tells whether the suite is in the registry of pairing
+	// suites, which FindPairing looks up, rather than in the one of Find.
+	Pairing bool
+}
 
-// register is called by suites to make themselves known to Kyber.
-//
-func register(s Suite) {
-	suites[strings.ToLower(s.String())] = s
+var (
+	mutex    sync.RWMutex
+	suites   = map[string]Suite{}
+	pairings = map[string]pairing.Suite{}
+	infos    = map[string]Info{}
+)
+
+// Register makes the suite s known under the name returned by its String
+// method, with the given estimated security level in bits, and tells
+// whether it runs in constant time. Names are case-insensitive. Register
+// panics if a suite is already registered under the same name.
+func Register(s Suite, securityLevel int, constantTime bool) {
+	name := strings.ToLower(s.String())
+	mutex.Lock()
+	defer mutex.Unlock()
+	addInfo(name, s, securityLevel, constantTime, false)
+	suites[name] = s
+}
+
+// RegisterPairing makes the pairing suite s known under the given name, as
+// Register does for other suites.
+func RegisterPairing(name string, s pairing.Suite, securityLevel int, constantTime bool) {
+	name = strings.ToLower(name)
+	mutex.Lock()
+	defer mutex.Unlock()
+	addInfo(name, s.G1(), securityLevel, constantTime, true)
+	pairings[name] = s
+}
+
+// addInfo records the description of a suite whose group, or group G1, is
+// g. The caller must hold the lock.
+func addInfo(name string, g kyber.Group, securityLevel int, constantTime, isPairing bool) {
+	if _, ok := infos[name]; ok {
+		panic("suites: suite " + name + " registered twice")
+	}
+	infos[name] = Info{
+		Name:          name,
+		SecurityLevel: securityLevel,
+		PointLen:      g.PointLen(),
+		ScalarLen:     g.ScalarLen(),
+		ConstantTime:  constantTime,
+		Pairing:       isPairing,
+	}
 }
 
 // ErrUnknownSuite indicates that the suite was not one of the
@@ -39,6 +107,8 @@ var ErrUnknownSuite = errors.New("unknown suite")
 
 // Find looks up a suite by name.
 func Find(name string) (Suite, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
 	if s, ok := suites[strings.ToLower(name)]; ok {
 		return s, nil
 	}
@@ -53,3 +123,47 @@ func MustFind(name string) Suite {
 	}
 	return s
 }
+
+// FindPairing looks up a pairing suite by name.
+func FindPairing(name string) (pairing.Suite, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	if s, ok := pairings[strings.ToLower(name)]; ok {
+		return s, nil
+	}
+	return nil, ErrUnknownSuite
+}
+
+// MustFindPairing looks up a pairing suite by name and panics if it is not
+// found.
+func MustFindPairing(name string) pairing.Suite {
+	s, err := FindPairing(name)
+	if err != nil {
+		panic("Pairing suite " + name + " not found.")
+	}
+	return s
+}
+
+// Lookup returns the description of a suite or pairing suite by name, whose
+// Pairing field tells whether to get it with Find or FindPairing.
+func Lookup(name string) (Info, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	if info, ok := infos[strings.ToLower(name)]; ok {
+		return info, nil
+	}
+	return Info{}, ErrUnknownSuite
+}
+
+// List returns the descriptions of all the registered suites and pairing
+// suites, sorted by name.
+func List() []Info {
+	mutex.RLock()
+	list := make([]Info, 0, len(infos))
+	for _, info := range infos {
+		list = append(list, info)
+	}
+	mutex.RUnlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
@@ -0,0 +1,98 @@
+package suites
+
+import (
+	"crypto/sha512"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/strict"
+	"github.com/dedis/kyber/util/test"
+	"github.com/dedis/kyber/xof/blake2xb"
+	"github.com/dedis/kyber/xof/keccak"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	s, err := Find("Ed25519")
+	require.NoError(t, err)
+	require.Equal(t, "Ed25519", s.String())
+	_, err = FindPairing("ed25519")
+	require.Equal(t, ErrUnknownSuite, err)
+
+	p, err := FindPairing("BN256")
+	require.NoError(t, err)
+	require.Equal(t, "bn256.G1", p.G1().String())
+	_, err = Find("bn256")
+	require.Equal(t, ErrUnknownSuite, err)
+
+	_, err = Lookup("unknown")
+	require.Equal(t, ErrUnknownSuite, err)
+}
+
+func TestLookup(t *testing.T) {
+	info, err := Lookup("ed25519")
+	require.NoError(t, err)
+	require.Equal(t, Info{"ed25519", 128, 32, 32, true, false}, info)
+
+	info, err = Lookup("E-521")
+	require.NoError(t, err)
+	require.Equal(t, Info{"e-521", 259, 66, 65, true, false}, info)
+
+	info, err = Lookup("bn256")
+	require.NoError(t, err)
+	require.True(t, info.Pairing)
+	require.False(t, info.ConstantTime)
+
+	list := List()
+	for i, info := range list {
+		if i > 0 {
+			require.True(t, list[i-1].Name < info.Name)
+		}
+		if info.Pairing {
+			MustFindPairing(info.Name)
+		} else {
+			MustFind(info.Name)
+		}
+	}
+}
+
+type customSuite struct {
+	*edwards25519.SuiteEd25519
+}
+
+func (s customSuite) String() string { return "Custom" }
+
+func TestRegister(t *testing.T) {
+	Register(customSuite{edwards25519.NewBlakeSHA256Ed25519()}, 128, true)
+	s, err := Find("custom")
+	require.NoError(t, err)
+	require.Equal(t, "Custom", s.String())
+
+	require.Panics(t, func() {
+		Register(edwards25519.NewBlakeSHA256Ed25519(), 128, true)
+	})
+	require.Panics(t, func() {
+		RegisterPairing("custom", MustFindPairing("bn256"), 100, false)
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(new(edwards25519.Curve))
+	s := b.Build()
+	require.Equal(t, "Ed25519", s.String())
+	require.Equal(t, 32, s.Hash().Size())
+
+	s = b.WithName("Ed25519-SHA512").WithHash(sha512.New).WithXOF(keccak.New).Build()
+	require.Equal(t, "Ed25519-SHA512", s.String())
+	require.Equal(t, 64, s.Hash().Size())
+	test.SuiteTest(s)
+
+	// Keys are generated as the group requires.
+	k := s.(key.Generator).NewKey(blake2xb.New([]byte("seed")))
+	k2 := new(edwards25519.Curve).NewKey(blake2xb.New([]byte("seed")))
+	require.True(t, k.Equal(k2))
+
+	require.False(t, strict.Enabled(s))
+	require.True(t, strict.Enabled(b.WithStrictDecoding().Build()))
+}
@@ -37,3 +37,15 @@ func TestNewKeyPairGen(t *testing.T) {
 		t.Fatalf("expected fixed private key, got %v", key.Private)
 	}
 }
+
+func TestNewHidingKeyPair(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	keypair := NewHidingKeyPair(suite)
+	pub := suite.Point().Mul(keypair.Private, nil)
+	if !pub.Equal(keypair.Public) {
+		t.Fatal("Public and private keys don't match")
+	}
+	if keypair.Hiding == nil {
+		t.Fatal("Public key is not hiding-encodable")
+	}
+}
@@ -0,0 +1,178 @@
+// Package msm speeds up scalar multiplications, which are the bulk of the work
+// needed to verify most signatures and proofs: sums of scalar multiples of
+// group elements, and repeated multiplications of the same element.
+package msm
+
+import (
+	"math/big"
+
+	"github.com/dedis/kyber"
+)
+
+// MultiMul sets p to scalars[0]*points[0] + ... + scalars[n-1]*points[n-1]
+// and returns it, where a nil point stands for the standard base point. If p
+// implements kyber.MultiScalarMul, its faster, possibly variable-time,
+// algorithm is used; otherwise the sum is computed one Mul at a time.
+func MultiMul(p kyber.Point, scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("msm: mismatched number of scalars and points")
+	}
+	if m, ok := p.(kyber.MultiScalarMul); ok {
+		return m.MultiMul(scalars, points)
+	}
+	sum := p.Clone().Null()
+	tmp := p.Clone()
+	for i := range scalars {
+		sum.Add(sum, tmp.Mul(scalars[i], points[i]))
+	}
+	return p.Set(sum)
+}
+
+// Precompute returns a copy of p carrying a table that speeds up its
+// multiplications by scalars if p implements kyber.Precomputable, and p
+// itself otherwise.
+func Precompute(p kyber.Point) kyber.Point {
+	if pre, ok := p.(kyber.Precomputable); ok {
+		return pre.Precompute()
+	}
+	return p
+}
+
+// MultiMulInt sets p to the sum of the points multiplied by the given
+// non-negative integers, where a nil point stands for the standard base
+// point, and returns it. It only relies on the Add method of the points, and
+// so lets groups implement kyber.MultiScalarMul on top of their existing
+// arithmetic. The sum is computed with Straus's interleaved windows when there
+// are few points, and with Pippenger's bucket method otherwise, both of which
+// run in variable time.
+func MultiMulInt(p kyber.Point, scalars []*big.Int, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("msm: mismatched number of scalars and points")
+	}
+	b := 0
+	for _, s := range scalars {
+		if s.Sign() < 0 {
+			panic("msm: negative scalar")
+		}
+		if s.BitLen() > b {
+			b = s.BitLen()
+		}
+	}
+	pts := make([]kyber.Point, len(points))
+	for i, q := range points {
+		if q == nil {
+			q = p.Clone().Base()
+		}
+		pts[i] = q
+	}
+
+	c := bucketWindow(len(pts), b)
+	if c == 0 {
+		return p.Set(straus(p, scalars, pts, b))
+	}
+	return p.Set(pippenger(p, scalars, pts, b, c))
+}
+
+// strausWindow is the width of the windows used by Straus's method.
+const strausWindow = 4
+
+// bucketWindow returns the window width that minimizes the number of point
+// additions done by Pippenger's method on n scalars of b bits, or 0 if
+// Straus's method needs fewer additions.
+func bucketWindow(n, b int) int {
+	best := n*((b+strausWindow-1)/strausWindow+1<<strausWindow-2) + b
+	window := 0
+	for c := 2; c <= 16; c++ {
+		cost := (b + c - 1) / c * (n + 2<<uint(c) + c)
+		if cost < best {
+			best, window = cost, c
+		}
+	}
+	return window
+}
+
+// digit returns the c-bit window of s starting at bit i.
+func digit(s *big.Int, i, c int) int {
+	d := 0
+	for j := c - 1; j >= 0; j-- {
+		d = d<<1 | int(s.Bit(i+j))
+	}
+	return d
+}
+
+// straus computes the sum by sharing the doublings between all the points,
+// each of which contributes at most one addition from a table of its small
+// multiples per window.
+func straus(p kyber.Point, scalars []*big.Int, points []kyber.Point, b int) kyber.Point {
+	tables := make([][]kyber.Point, len(points))
+	for i, q := range points {
+		t := make([]kyber.Point, 1<<strausWindow-1)
+		t[0] = q.Clone()
+		for k := 1; k < len(t); k++ {
+			t[k] = q.Clone().Add(t[k-1], q)
+		}
+		tables[i] = t
+	}
+
+	sum := p.Clone().Null()
+	for i := (b + strausWindow - 1) / strausWindow * strausWindow; i > 0; {
+		i -= strausWindow
+		for j := 0; j < strausWindow; j++ {
+			sum.Add(sum, sum)
+		}
+		for k, s := range scalars {
+			if d := digit(s, i, strausWindow); d != 0 {
+				sum.Add(sum, tables[k][d-1])
+			}
+		}
+	}
+	return sum
+}
+
+// pippenger computes the sum by sorting, in each window, the points into
+// buckets according to their digit, and then summing the buckets weighted by
+// their digit with a running sum.
+func pippenger(p kyber.Point, scalars []*big.Int, points []kyber.Point, b, c int) kyber.Point {
+	buckets := make([]kyber.Point, 1<<uint(c)-1)
+	for k := range buckets {
+		buckets[k] = p.Clone()
+	}
+	used := make([]bool, len(buckets))
+	running := p.Clone()
+	window := p.Clone()
+
+	sum := p.Clone().Null()
+	for i := (b + c - 1) / c * c; i > 0; {
+		i -= c
+		for j := 0; j < c; j++ {
+			sum.Add(sum, sum)
+		}
+
+		for k := range used {
+			used[k] = false
+		}
+		for k, s := range scalars {
+			d := digit(s, i, c)
+			if d == 0 {
+				continue
+			}
+			if used[d-1] {
+				buckets[d-1].Add(buckets[d-1], points[k])
+			} else {
+				buckets[d-1].Set(points[k])
+				used[d-1] = true
+			}
+		}
+
+		running.Null()
+		window.Null()
+		for k := len(buckets) - 1; k >= 0; k-- {
+			if used[k] {
+				running.Add(running, buckets[k])
+			}
+			window.Add(window, running)
+		}
+		sum.Add(sum, window)
+	}
+	return sum
+}
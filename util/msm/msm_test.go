@@ -0,0 +1,60 @@
+package msm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// plainPoint hides the MultiMul method of the point it wraps.
+type plainPoint struct {
+	kyber.Point
+}
+
+func TestMultiMulFallback(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	scalars := make([]kyber.Scalar, 5)
+	points := make([]kyber.Point, 5)
+	sum := suite.Point().Null()
+	for i := range points {
+		scalars[i] = suite.Scalar().Pick(random.New())
+		points[i] = suite.Point().Pick(random.New())
+		sum.Add(sum, suite.Point().Mul(scalars[i], points[i]))
+	}
+	p := &plainPoint{suite.Point()}
+	require.True(t, sum.Equal(MultiMul(p, scalars, points)))
+	require.True(t, sum.Equal(p.Point))
+}
+
+func TestMultiMulInt(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	for _, n := range []int{0, 1, 3, 20, 300} {
+		ints := make([]*big.Int, n)
+		points := make([]kyber.Point, n)
+		sum := suite.Point().Null()
+		for i := range points {
+			s := suite.Scalar().Pick(random.New())
+			buf, err := s.MarshalBinary()
+			require.Nil(t, err)
+			for j, k := 0, len(buf)-1; j < k; j, k = j+1, k-1 {
+				buf[j], buf[k] = buf[k], buf[j]
+			}
+			ints[i] = new(big.Int).SetBytes(buf)
+			if i%2 == 0 {
+				points[i] = suite.Point().Pick(random.New())
+			}
+			sum.Add(sum, suite.Point().Mul(s, points[i]))
+		}
+		p := MultiMulInt(suite.Point(), ints, points)
+		require.True(t, sum.Equal(p), "n = %d", n)
+	}
+}
+
+func TestBucketWindow(t *testing.T) {
+	require.Equal(t, 0, bucketWindow(2, 256))
+	require.NotEqual(t, 0, bucketWindow(1000, 256))
+}
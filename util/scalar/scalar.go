@@ -0,0 +1,70 @@
+// Package scalar derives Scalars from hashes and other uniform byte strings
+// without bias. Reducing a string of ScalarLen bytes modulo the order of a
+// group does not yield uniform Scalars unless the order is very close to a
+// power of two, so the string must first be made at least WideLen bytes
+// long, which bounds the statistical distance from uniform by 2^-128.
+//
+// The challenges of the schnorr and cosi signatures are derived with
+// FromDigest only if their suite enables wide challenges, by implementing
+// the Suite interface as the suites of suites.Builder do after
+// WithWideChallenges. Otherwise they are still derived with SetBytes, as
+// the challenges of the signatures made so far. Both derivations give
+// different challenges for most groups, so that signers and verifiers must
+// agree on the derivation, which is a property of the signature scheme.
+package scalar
+
+import (
+	"github.com/dedis/kyber"
+	"golang.org/x/crypto/sha3"
+)
+
+// margin is the number of bytes a uniform string must have beyond ScalarLen
+// for its reduction to be indistinguishable from a uniform Scalar.
+const margin = 16
+
+// WideLen returns the minimum length of the uniform strings from which
+// SetBytesWide derives the Scalars of g without bias: 48 bytes for 256-bit
+// groups, so that the 64-byte output of SHA-512 is always enough for them.
+func WideLen(g kyber.Group) int {
+	return g.ScalarLen() + margin
+}
+
+// SetBytesWide sets s to b reduced modulo the order of its group, using
+// kyber.ExtendedScalar if s implements it and SetBytes otherwise, and
+// returns s.
+func SetBytesWide(s kyber.Scalar, b []byte) kyber.Scalar {
+	if e, ok := s.(kyber.ExtendedScalar); ok {
+		return e.SetBytesWide(b)
+	}
+	return s.SetBytes(b)
+}
+
+// Suite is an optional interface implemented by the suites that enable wide
+// challenges.
+type Suite interface {
+	WideChallenges() bool
+}
+
+// Challenge returns the Scalar of g derived from the digest of a signature
+// scheme: with FromDigest if g implements Suite and enables wide
+// challenges, or with SetBytes otherwise.
+func Challenge(g kyber.Group, digest []byte) kyber.Scalar {
+	if s, ok := g.(Suite); ok && s.WideChallenges() {
+		return FromDigest(g, digest)
+	}
+	return g.Scalar().SetBytes(digest)
+}
+
+// FromDigest returns a Scalar of g derived from the output of a hash
+// function. A digest of at least WideLen(g) bytes is reduced as is, so that
+// for instance SHA-512 digests give the same Scalars as SetBytes in 256-bit
+// groups, as EdDSA requires. Shorter digests are first expanded to
+// WideLen(g) bytes with SHAKE256.
+func FromDigest(g kyber.Group, digest []byte) kyber.Scalar {
+	if n := WideLen(g); len(digest) < n {
+		wide := make([]byte, n)
+		sha3.ShakeSum256(wide, digest)
+		digest = wide
+	}
+	return SetBytesWide(g.Scalar(), digest)
+}
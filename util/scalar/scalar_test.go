@@ -0,0 +1,50 @@
+package scalar
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/ed448"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromDigest(t *testing.T) {
+	g := new(edwards25519.Curve)
+	require.Equal(t, 48, WideLen(g))
+
+	// SHA-512 digests are reduced as is, as in EdDSA.
+	d := sha512.Sum512([]byte("kyber"))
+	require.True(t, FromDigest(g, d[:]).Equal(g.Scalar().SetBytes(d[:])))
+
+	// SHA-256 digests are expanded first.
+	d2 := sha256.Sum256([]byte("kyber"))
+	s := FromDigest(g, d2[:])
+	require.False(t, s.Equal(g.Scalar().SetBytes(d2[:])))
+	require.True(t, s.Equal(FromDigest(g, d2[:])))
+
+	// SHA-512 digests are too short for Decaf448.
+	g448 := new(ed448.Curve)
+	require.Equal(t, 72, WideLen(g448))
+	require.False(t, FromDigest(g448, d[:]).Equal(g448.Scalar().SetBytes(d[:])))
+}
+
+// wideGroup enables wide challenges.
+type wideGroup struct {
+	kyber.Group
+}
+
+func (g wideGroup) WideChallenges() bool {
+	return true
+}
+
+func TestChallenge(t *testing.T) {
+	g := new(edwards25519.Curve)
+	d := sha256.Sum256([]byte("kyber"))
+
+	// Challenges are derived with SetBytes by default.
+	require.True(t, Challenge(g, d[:]).Equal(g.Scalar().SetBytes(d[:])))
+	require.True(t, Challenge(wideGroup{g}, d[:]).Equal(FromDigest(g, d[:])))
+}
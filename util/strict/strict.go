@@ -0,0 +1,107 @@
+// Package strict checks the points that protocols receive from other
+// parties: a point is accepted only if its encoding is canonical, it is in
+// the subgroup generated by the standard base point, and it does not have a
+// small order, the identity element included. Points of small order or
+// outside of the subgroup let a malicious party learn information about
+// secret scalars multiplied with them, or bias the outcome of a protocol.
+//
+// The subgroup checks rely on the kyber.Validator interface. Points that do
+// not implement it are only checked for canonical encodings.
+//
+// The vss, dkg, cosi and pvss packages check the points they receive with
+// this package only if their suite enables strict decoding, as rejecting
+// points that were accepted so far would break existing deployments. A
+// suite enables it by implementing the Suite interface, as the suites of
+// suites.Builder do after WithStrictDecoding.
+package strict
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+var (
+	// ErrNonCanonical is returned for points whose encoding is not the
+	// one MarshalBinary produces.
+	ErrNonCanonical = errors.New("strict: non-canonical point encoding")
+	// ErrNotInSubgroup is returned for points outside of the subgroup
+	// generated by the base point.
+	ErrNotInSubgroup = errors.New("strict: point is not in the prime-order subgroup")
+	// ErrSmallOrder is returned for points of small order, the identity
+	// element included.
+	ErrSmallOrder = errors.New("strict: point of small order")
+)
+
+// Suite is an optional interface implemented by the suites that enable
+// strict decoding.
+type Suite interface {
+	StrictDecoding() bool
+}
+
+// Enabled reports whether suite implements Suite and enables strict
+// decoding.
+func Enabled(suite interface{}) bool {
+	s, ok := suite.(Suite)
+	return ok && s.StrictDecoding()
+}
+
+// Check returns an error if p is not in the subgroup generated by the base
+// point, or if it has a small order.
+func Check(p kyber.Point) error {
+	v, ok := p.(kyber.Validator)
+	if !ok {
+		return nil
+	}
+	if !v.Valid() {
+		return ErrNotInSubgroup
+	}
+	if v.IsSmallOrder() {
+		return ErrSmallOrder
+	}
+	return nil
+}
+
+// CheckPoints calls Check on every point and returns the first error.
+func CheckPoints(points []kyber.Point) error {
+	for _, p := range points {
+		if err := Check(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodePoint decodes a point of g from buf as UnmarshalPoint does if
+// Enabled(g), or only with UnmarshalBinary otherwise.
+func DecodePoint(g kyber.Group, buf []byte) (kyber.Point, error) {
+	if Enabled(g) {
+		return UnmarshalPoint(g, buf)
+	}
+	p := g.Point()
+	if err := p.UnmarshalBinary(buf); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UnmarshalPoint decodes a point of g from buf, and returns an error if the
+// encoding is invalid or not canonical, or if Check rejects the point.
+func UnmarshalPoint(g kyber.Group, buf []byte) (kyber.Point, error) {
+	p := g.Point()
+	if err := p.UnmarshalBinary(buf); err != nil {
+		return nil, err
+	}
+	canonical, err := p.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(canonical, buf) {
+		return nil, ErrNonCanonical
+	}
+	if err := Check(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,56 @@
+package strict
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/ristretto255"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalPoint(t *testing.T) {
+	for _, g := range []kyber.Group{new(edwards25519.Curve), new(ristretto255.Curve)} {
+		p := g.Point().Pick(random.New())
+		buf, _ := p.MarshalBinary()
+		q, err := UnmarshalPoint(g, buf)
+		require.NoError(t, err)
+		require.True(t, p.Equal(q))
+
+		buf, _ = g.Point().Null().MarshalBinary()
+		_, err = UnmarshalPoint(g, buf)
+		require.Equal(t, ErrSmallOrder, err)
+	}
+}
+
+func TestEd25519SmallOrder(t *testing.T) {
+	g := new(edwards25519.Curve)
+
+	// A point of order 8, and its sum with a point of order l.
+	buf, _ := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	_, err := UnmarshalPoint(g, buf)
+	require.Equal(t, ErrNotInSubgroup, err)
+
+	T := g.Point()
+	require.NoError(t, T.UnmarshalBinary(buf))
+	P := g.Point().Add(T, g.Point().Pick(random.New()))
+	buf, _ = P.MarshalBinary()
+	_, err = UnmarshalPoint(g, buf)
+	require.Equal(t, ErrNotInSubgroup, err)
+
+	Q := g.Point().(kyber.Validator).ClearCofactor(P)
+	require.NoError(t, Check(Q))
+	require.True(t, T.(kyber.Validator).IsSmallOrder())
+}
+
+func TestNonCanonical(t *testing.T) {
+	g := new(edwards25519.Curve)
+
+	// y = p + 1 encodes the same point as y = 1, the identity.
+	buf, _ := hex.DecodeString("eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	require.NoError(t, g.Point().UnmarshalBinary(buf))
+	_, err := UnmarshalPoint(g, buf)
+	require.Equal(t, ErrNonCanonical, err)
+}
@@ -3,9 +3,11 @@ package test
 import (
 	"bytes"
 	"crypto/cipher"
+	"math/big"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/msm"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -130,6 +132,127 @@ func testScalarClone(g kyber.Group, rand cipher.Stream) {
 	}
 }
 
+func testMultiMul(g kyber.Group, rand cipher.Stream) {
+	for _, n := range []int{0, 1, 2, 7, 40} {
+		scalars := make([]kyber.Scalar, n)
+		points := make([]kyber.Point, n)
+		sum := g.Point().Null()
+		for i := range points {
+			scalars[i] = g.Scalar().Pick(rand)
+			if i%3 != 1 {
+				points[i] = g.Point().Pick(rand)
+			}
+			sum.Add(sum, g.Point().Mul(scalars[i], points[i]))
+		}
+		if !msm.MultiMul(g.Point(), scalars, points).Equal(sum) {
+			panic("multi-scalar multiplication fails")
+		}
+	}
+}
+
+func testPrecompute(g kyber.Group, rand cipher.Stream) {
+	p := g.Point().Pick(rand)
+	pre := msm.Precompute(p)
+	if !pre.Equal(p) {
+		panic("precomputed point differs from original")
+	}
+	for i := 0; i < 5; i++ {
+		s := g.Scalar().Pick(rand)
+		if !g.Point().Mul(s, pre).Equal(g.Point().Mul(s, p)) {
+			panic("multiplication of precomputed point fails")
+		}
+	}
+
+	// The table must no longer be used once the point changes.
+	s := g.Scalar().Pick(rand)
+	pre.Add(pre, p)
+	p.Add(p, p)
+	if !g.Point().Mul(s, pre).Equal(g.Point().Mul(s, p)) {
+		panic("multiplication of modified precomputed point fails")
+	}
+	if !g.Point().Mul(s, pre.Clone()).Equal(g.Point().Mul(s, p)) {
+		panic("multiplication of cloned precomputed point fails")
+	}
+}
+
+func testValidator(g kyber.Group, rand cipher.Stream) {
+	if _, ok := g.Point().(kyber.Validator); !ok {
+		return
+	}
+	base := g.Point().Base().(kyber.Validator)
+	if !base.Valid() || base.IsSmallOrder() {
+		panic("base point fails validation")
+	}
+	null := g.Point().Null().(kyber.Validator)
+	if !null.Valid() || !null.IsSmallOrder() {
+		panic("identity element fails validation")
+	}
+	p := g.Point().Pick(rand)
+	if !p.(kyber.Validator).Valid() {
+		panic("random point fails validation")
+	}
+	q := g.Point().(kyber.Validator).ClearCofactor(p)
+	if !q.(kyber.Validator).Valid() {
+		panic("point with cleared cofactor fails validation")
+	}
+}
+
+func testExtendedScalar(g kyber.Group, rand cipher.Stream) {
+	// Square roots and Legendre symbols are only defined modulo primes.
+	gi, ok := g.(kyber.GroupInfo)
+	if _, ext := g.Scalar().(kyber.ExtendedScalar); !ext || !ok ||
+		!gi.Order().ProbablyPrime(20) {
+		return
+	}
+	x := g.Scalar().Pick(rand)
+	x3 := g.Scalar().Mul(x, x)
+	x3.Mul(x3, x)
+	if !g.Scalar().(kyber.ExtendedScalar).Exp(x, big.NewInt(3)).Equal(x3) {
+		panic("Exp does not match Mul")
+	}
+	if !g.Scalar().(kyber.ExtendedScalar).Exp(x, big.NewInt(-1)).Equal(g.Scalar().Inv(x)) {
+		panic("Exp with a negative exponent does not match Inv")
+	}
+
+	if g.Scalar().Zero().(kyber.ExtendedScalar).Legendre() != 0 {
+		panic("Legendre symbol of zero is not 0")
+	}
+	sq := g.Scalar().Mul(x, x)
+	if sq.(kyber.ExtendedScalar).Legendre() != 1 {
+		panic("Legendre symbol of a square is not 1")
+	}
+	r := g.Scalar()
+	if !r.(kyber.ExtendedScalar).Sqrt(sq) || !g.Scalar().Mul(r, r).Equal(sq) {
+		panic("Sqrt of a square failed")
+	}
+	for i := 0; i < 64; i++ {
+		n := g.Scalar().Pick(rand)
+		if n.(kyber.ExtendedScalar).Legendre() == -1 {
+			if r.(kyber.ExtendedScalar).Sqrt(n) {
+				panic("Sqrt of a non-square succeeded")
+			}
+			break
+		}
+	}
+}
+
+func testGroupInfo(g kyber.Group) {
+	gi, ok := g.(kyber.GroupInfo)
+	if !ok {
+		return
+	}
+	order := gi.Order()
+	if order.Sign() <= 0 || g.ScalarLen() < (order.BitLen()+7)/8 {
+		panic("group order does not match the scalars")
+	}
+	if gi.Cofactor().Sign() <= 0 {
+		panic("group cofactor is not positive")
+	}
+	if gi.IsPrimeOrder() && !order.ProbablyPrime(20) {
+		panic("group of prime order has a composite order")
+	}
+}
+
 // Apply a generic set of validation tests to a cryptographic Group,
 // using a given source of [pseudo-]randomness.
 //
@@ -171,15 +294,12 @@ func testGroup(g kyber.Group, rand cipher.Stream) []kyber.Point {
 	}
 	points = append(points, p1)
 
-	// Find out if this curve has a prime order:
-	// if the curve does not offer a method IsPrimeOrder,
-	// then assume that it is.
-	type canCheckPrimeOrder interface {
-		IsPrimeOrder() bool
-	}
+	// Find out if the scalars are taken modulo a prime:
+	// if the group does not implement kyber.GroupInfo,
+	// then assume that they are.
 	primeOrder := true
-	if gpo, ok := g.(canCheckPrimeOrder); ok {
-		primeOrder = gpo.IsPrimeOrder()
+	if gi, ok := g.(kyber.GroupInfo); ok {
+		primeOrder = gi.Order().ProbablyPrime(20)
 	}
 
 	// Verify additive and multiplicative identities of the generator.
@@ -336,6 +456,11 @@ func testGroup(g kyber.Group, rand cipher.Stream) []kyber.Point {
 	testPointClone(g, rand)
 	testScalarSet(g, rand)
 	testScalarClone(g, rand)
+	testMultiMul(g, rand)
+	testPrecompute(g, rand)
+	testValidator(g, rand)
+	testExtendedScalar(g, rand)
+	testGroupInfo(g)
 
 	return points
 }